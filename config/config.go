@@ -1,16 +1,20 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"path"
+	"time"
 
+	"github.com/keshu12345/overlap-avalara/data"
 	"github.com/keshu12345/overlap-avalara/toolkit"
 	logger "github.com/sirupsen/logrus"
 	"go.uber.org/fx"
 )
 
 const (
-	serverYML = "server.yml"
+	serverYML          = "server.yml"
+	defaultCalendarDir = "calendars"
 )
 
 // NewFxModule returns the fx.Option that builds the *Configuration struct
@@ -25,7 +29,11 @@ func NewFxModule(configDirPath string, overridePath string) fx.Option {
 			}
 			logger.Info("Using config path ", path.Join(configDirPath, serverYML))
 			err := toolkit.NewConfig(&conf, path.Join(configDirPath, serverYML), overridePath)
-			return &conf, err
+			if err != nil {
+				return &conf, err
+			}
+			conf.Calendar.Dir = resolveDir(configDirPath, conf.Calendar.Dir, defaultCalendarDir)
+			return &conf, conf.validate()
 		},
 	)
 }
@@ -34,6 +42,40 @@ type Configuration struct {
 	EnvironmentName string
 	Server          Server       `mapstructure:"server"`
 	Logger          LoggerConfig `mapstructure:"logger"`
+	Overlap         Overlap      `mapstructure:"overlap"`
+	Validation      Validation   `mapstructure:"validation"`
+	Calendar        Calendar     `mapstructure:"calendar"`
+}
+
+// resolveDir makes dir, or fallback when dir is empty, relative to the config dir.
+func resolveDir(configDirPath, dir, fallback string) string {
+	if dir == "" {
+		dir = fallback
+	}
+	if path.IsAbs(dir) {
+		return dir
+	}
+	return path.Join(configDirPath, dir)
+}
+
+// validate rejects settings that would otherwise only fail once a request comes in.
+func (c *Configuration) validate() error {
+	if c.Overlap.DefaultBoundary != "" && !c.Overlap.DefaultBoundary.Valid() {
+		return fmt.Errorf("overlap.defaultBoundary %q must be one of [] [) (] ()", c.Overlap.DefaultBoundary)
+	}
+	if c.Overlap.MaxOccurrences < 0 {
+		return fmt.Errorf("overlap.maxOccurrences must not be negative, got %d", c.Overlap.MaxOccurrences)
+	}
+	if c.Overlap.MaxFilingPeriods < 0 {
+		return fmt.Errorf("overlap.maxFilingPeriods must not be negative, got %d", c.Overlap.MaxFilingPeriods)
+	}
+	if c.Validation.MaxSpan < 0 {
+		return fmt.Errorf("validation.maxSpan must not be negative, got %s", c.Validation.MaxSpan)
+	}
+	if c.Validation.MinYear != 0 && c.Validation.MaxYear != 0 && c.Validation.MinYear > c.Validation.MaxYear {
+		return fmt.Errorf("validation.minYear %d is after validation.maxYear %d", c.Validation.MinYear, c.Validation.MaxYear)
+	}
+	return nil
 }
 
 type Server struct {
@@ -43,6 +85,26 @@ type Server struct {
 	IdleTimeout  int
 }
 
+// Overlap holds the server-wide defaults of the overlap service.
+type Overlap struct {
+	DefaultBoundary  data.Boundary `mapstructure:"defaultBoundary"`  // e.g., "[)", used when a request sends none
+	MaxOccurrences   int           `mapstructure:"maxOccurrences"`   // most occurrences a recurring series expands to, e.g., 10000
+	MaxFilingPeriods int           `mapstructure:"maxFilingPeriods"` // most filing periods one range may span, e.g., 1200
+}
+
+// Validation holds the semantic checks applied to every date range in a request.
+type Validation struct {
+	RejectZeroLength bool          `mapstructure:"rejectZeroLength"` // reject ranges whose start equals their end
+	MaxSpan          time.Duration `mapstructure:"maxSpan"`          // e.g., "8760h", 0 for no limit
+	MinYear          int           `mapstructure:"minYear"`          // earliest supported year, e.g., 1900
+	MaxYear          int           `mapstructure:"maxYear"`          // latest supported year, e.g., 2199
+}
+
+// Calendar says where the holiday calendar files live.
+type Calendar struct {
+	Dir string `mapstructure:"dir"` // e.g., "calendars", relative to the config dir
+}
+
 type LoggerConfig struct {
 	Base         string `yaml:"base"`         // e.g., "logrus"
 	Level        string `yaml:"level"`        // e.g., "info", "debug"
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"go.uber.org/fx"
 )
@@ -134,3 +135,168 @@ server:
 		t.Errorf("expected overridden Server.Port=9090; got %d", cfg.Server.Port)
 	}
 }
+
+func TestNewFxModule_OverlapDefaults(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "configtest")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	writeFile(t, tmpDir, "server.yml", `
+server:
+  port: 8080
+overlap:
+  defaultBoundary: "[]"
+  maxFilingPeriods: 240
+`)
+
+	var cfg *Configuration
+	app := fx.New(
+		NewFxModule(tmpDir, ""),
+		fx.Populate(&cfg),
+	)
+	if err := app.Start(context.Background()); err != nil {
+		t.Fatalf("failed to start fx app: %v", err)
+	}
+	defer app.Stop(context.Background())
+
+	if cfg.Overlap.DefaultBoundary != "[]" {
+		t.Errorf("expected Overlap.DefaultBoundary=\"[]\"; got %q", cfg.Overlap.DefaultBoundary)
+	}
+	if cfg.Overlap.MaxFilingPeriods != 240 {
+		t.Errorf("expected Overlap.MaxFilingPeriods=240; got %d", cfg.Overlap.MaxFilingPeriods)
+	}
+}
+
+func TestNewFxModule_RejectsNegativeMaxFilingPeriods(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "configtest")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	writeFile(t, tmpDir, "server.yml", `
+overlap:
+  maxFilingPeriods: -1
+`)
+
+	app := fx.New(NewFxModule(tmpDir, ""), fx.Invoke(func(*Configuration) {}))
+	if app.Err() == nil {
+		t.Fatal("expected an error for a negative maxFilingPeriods")
+	}
+}
+
+func TestNewFxModule_RejectsUnknownBoundary(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "configtest")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	writeFile(t, tmpDir, "server.yml", `
+overlap:
+  defaultBoundary: "<>"
+`)
+
+	var cfg *Configuration
+	app := fx.New(
+		NewFxModule(tmpDir, ""),
+		fx.Populate(&cfg),
+	)
+	if app.Err() == nil {
+		t.Fatal("expected an error for an unknown default boundary")
+	}
+}
+
+func TestNewFxModule_ValidationRules(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "configtest")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	writeFile(t, tmpDir, "server.yml", `
+validation:
+  rejectZeroLength: true
+  maxSpan: 720h
+  minYear: 2000
+  maxYear: 2100
+`)
+
+	var cfg *Configuration
+	app := fx.New(
+		NewFxModule(tmpDir, ""),
+		fx.Populate(&cfg),
+	)
+	if err := app.Start(context.Background()); err != nil {
+		t.Fatalf("failed to start fx app: %v", err)
+	}
+	defer app.Stop(context.Background())
+
+	if !cfg.Validation.RejectZeroLength {
+		t.Error("expected Validation.RejectZeroLength=true")
+	}
+	if cfg.Validation.MaxSpan != 720*time.Hour {
+		t.Errorf("expected Validation.MaxSpan=720h; got %v", cfg.Validation.MaxSpan)
+	}
+	if cfg.Validation.MinYear != 2000 || cfg.Validation.MaxYear != 2100 {
+		t.Errorf("expected years 2000-2100; got %d-%d", cfg.Validation.MinYear, cfg.Validation.MaxYear)
+	}
+}
+
+func TestNewFxModule_RejectsInvalidValidationRules(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "configtest")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	writeFile(t, tmpDir, "server.yml", `
+validation:
+  minYear: 2100
+  maxYear: 2000
+`)
+
+	app := fx.New(NewFxModule(tmpDir, ""), fx.Invoke(func(*Configuration) {}))
+	if app.Err() == nil {
+		t.Fatal("expected an error when minYear is after maxYear")
+	}
+}
+
+func TestNewFxModule_CalendarDir(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "configtest")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	testCases := []struct {
+		name     string
+		yml      string
+		expected string
+	}{
+		{name: "Default", yml: "server:\n  port: 8080\n", expected: filepath.Join(tmpDir, "calendars")},
+		{name: "Relative", yml: "calendar:\n  dir: holidays\n", expected: filepath.Join(tmpDir, "holidays")},
+		{name: "Absolute", yml: "calendar:\n  dir: /etc/holidays\n", expected: "/etc/holidays"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			writeFile(t, tmpDir, "server.yml", tc.yml)
+
+			var cfg *Configuration
+			app := fx.New(
+				NewFxModule(tmpDir, ""),
+				fx.Populate(&cfg),
+			)
+			if err := app.Err(); err != nil {
+				t.Fatalf("failed to build fx app: %v", err)
+			}
+
+			if cfg.Calendar.Dir != tc.expected {
+				t.Errorf("expected Calendar.Dir=%q; got %q", tc.expected, cfg.Calendar.Dir)
+			}
+		})
+	}
+}
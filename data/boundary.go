@@ -0,0 +1,53 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Boundary says which ends of a range are part of the range, written the way
+// interval notation does: "[]" closed, "[)" half-open, "(]" and "()" open.
+type Boundary string
+
+const (
+	BoundaryClosed     Boundary = "[]"
+	BoundaryClosedOpen Boundary = "[)"
+	BoundaryOpenClosed Boundary = "(]"
+	BoundaryOpen       Boundary = "()"
+)
+
+func (b Boundary) String() string {
+	return string(b)
+}
+
+func (b Boundary) Valid() bool {
+	switch b {
+	case BoundaryClosed, BoundaryClosedOpen, BoundaryOpenClosed, BoundaryOpen:
+		return true
+	}
+	return false
+}
+
+func (b *Boundary) UnmarshalJSON(raw []byte) error {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return err
+	}
+	if s != "" && !Boundary(s).Valid() {
+		return fmt.Errorf("unknown boundary %q, expected one of [] [) (] ()", s)
+	}
+	*b = Boundary(s)
+	return nil
+}
+
+// OverlapOptions carries the per-request settings shared by every overlap
+// operation. Zero values fall back to the server defaults. Padding widens
+// every range of the request that has none of its own wherever conflicts are
+// looked for; relations and range-set operations ignore it. MinOverlap
+// filters the operations that report overlapping pairs; range-set operations
+// ignore it.
+type OverlapOptions struct {
+	Boundary   Boundary    `json:"boundary,omitempty"`
+	Padding    Padding     `json:"padding"`
+	MinOverlap *MinOverlap `json:"min_overlap,omitempty"`
+}
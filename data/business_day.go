@@ -0,0 +1,34 @@
+package data
+
+// BusinessDayOptions name the holiday calendar whose working days count.
+type BusinessDayOptions struct {
+	Calendar string `json:"calendar" binding:"required"`
+	OverlapOptions
+}
+
+// BusinessOverlapRequest intersects two ranges on working days only. Each
+// range is a timestamp range or a civil date range; dates are read in the
+// zone of the calendar.
+type BusinessOverlapRequest struct {
+	Range1 MixedRange `json:"range1" binding:"required"`
+	Range2 MixedRange `json:"range2" binding:"required"`
+	BusinessDayOptions
+}
+
+// BusinessOverlapResult is the part of an intersection that falls on working
+// days. Intersections are its maximal runs over consecutive working days,
+// given in the calendar's zone, and BusinessDays counts the working days
+// they touch.
+type BusinessOverlapResult struct {
+	Overlap       bool     `json:"overlap"`
+	Intersections RangeSet `json:"intersections"`
+	BusinessDays  int      `json:"business_days"`
+	Duration      Duration `json:"duration"`
+	Calendar      string   `json:"calendar"`
+	Boundary      Boundary `json:"boundary"`
+}
+
+// CalendarList names the holiday calendars the service knows.
+type CalendarList struct {
+	Calendars []string `json:"calendars"`
+}
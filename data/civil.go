@@ -0,0 +1,137 @@
+package data
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const civilLayout = "2006-01-02"
+
+// CivilDate is a calendar day such as "2025-03-31", with no time of day and no
+// zone. It is kept as midnight UTC of that day; the zero value is a missing
+// bound.
+type CivilDate time.Time
+
+// NewCivilDate returns the given calendar day.
+func NewCivilDate(year int, month time.Month, day int) CivilDate {
+	return CivilDate(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// Midnight returns midnight UTC of the day.
+func (d CivilDate) Midnight() time.Time {
+	return time.Time(d)
+}
+
+func (d CivilDate) IsZero() bool {
+	return time.Time(d).IsZero()
+}
+
+func (d CivilDate) String() string {
+	return time.Time(d).Format(civilLayout)
+}
+
+func (d CivilDate) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.String())
+}
+
+func (d *CivilDate) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		*d = CivilDate{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("date must be a string such as \"2025-03-31\": %w", err)
+	}
+	t, err := time.Parse(civilLayout, s)
+	if err != nil {
+		return fmt.Errorf("date %q must look like 2025-03-31", s)
+	}
+	if t.IsZero() {
+		return fmt.Errorf("date %q is not supported, use null for a missing bound", s)
+	}
+	*d = CivilDate(t)
+	return nil
+}
+
+// CivilDateRange is a run of whole calendar days, such as a tax period. Both
+// ends are inclusive, so a range from 2025-01-01 to 2025-01-01 is one day
+// long. As with DateRange, a null bound leaves that side open.
+type CivilDateRange struct {
+	Start CivilDate `json:"start"`
+	End   CivilDate `json:"end"`
+}
+
+// Days returns the number of days in the range, or nil when a bound is missing.
+func (r CivilDateRange) Days() *int {
+	if r.Start.IsZero() || r.End.IsZero() {
+		return nil
+	}
+	days := int(r.End.Midnight().Sub(r.Start.Midnight())/(24*time.Hour)) + 1
+	return &days
+}
+
+// UnmarshalJSON requires both bounds to be present, like DateRange does.
+func (r *CivilDateRange) UnmarshalJSON(b []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	if raw == nil {
+		return errors.New("date range must be an object with start and end")
+	}
+	if _, ok := raw["start"]; !ok {
+		return errors.New("date range start is required, use null for a range without a first day")
+	}
+	if _, ok := raw["end"]; !ok {
+		return errors.New("date range end is required, use null for a range without a last day")
+	}
+
+	type plain CivilDateRange
+	var in plain
+	if err := json.Unmarshal(b, &in); err != nil {
+		return err
+	}
+	*r = CivilDateRange(in)
+	return nil
+}
+
+type CivilOverlapRequest struct {
+	Range1 CivilDateRange `json:"range1" binding:"required"`
+	Range2 CivilDateRange `json:"range2" binding:"required"`
+}
+
+// CivilOverlapResult describes the days two civil date ranges share. Day
+// counts are null for ranges without an end.
+type CivilOverlapResult struct {
+	Overlap      bool            `json:"overlap"`
+	Intersection *CivilDateRange `json:"intersection,omitempty"`
+	Days         *int            `json:"days"`
+	Range1Days   *int            `json:"range1_days"`
+	Range2Days   *int            `json:"range2_days"`
+}
+
+// MixedRange is either a timestamp range or a civil date range; exactly one
+// of the two must be set.
+type MixedRange struct {
+	Range *DateRange      `json:"range,omitempty"`
+	Dates *CivilDateRange `json:"dates,omitempty"`
+}
+
+// MixedOptions carry the zone under which civil dates become instants: a day
+// runs from its midnight in Zone to the next one.
+type MixedOptions struct {
+	Zone string `json:"zone" binding:"required"`
+	OverlapOptions
+}
+
+type MixedOverlapRequest struct {
+	Range1 MixedRange `json:"range1" binding:"required"`
+	Range2 MixedRange `json:"range2" binding:"required"`
+	MixedOptions
+}
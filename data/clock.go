@@ -0,0 +1,49 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ClockTime is a time of day such as "09:00" or "17:30:00", kept as the time
+// since midnight. "24:00" is the end of the day.
+type ClockTime time.Duration
+
+// Since returns the time since midnight.
+func (c ClockTime) Since() time.Duration {
+	return time.Duration(c)
+}
+
+func (c ClockTime) String() string {
+	d := time.Duration(c)
+	h, m, s := int(d/time.Hour), int(d%time.Hour/time.Minute), int(d%time.Minute/time.Second)
+	if s != 0 {
+		return fmt.Sprintf("%02d:%02d:%02d", h, m, s)
+	}
+	return fmt.Sprintf("%02d:%02d", h, m)
+}
+
+func (c ClockTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+func (c *ClockTime) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("time of day must be a string such as \"09:00\": %w", err)
+	}
+	if s == "24:00" || s == "24:00:00" {
+		*c = ClockTime(24 * time.Hour)
+		return nil
+	}
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		if t, err = time.Parse("15:04:05", s); err != nil {
+			return fmt.Errorf("time of day %q must look like 09:00 or 09:00:00", s)
+		}
+	}
+	d := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+	*c = ClockTime(d)
+	return nil
+}
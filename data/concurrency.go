@@ -0,0 +1,38 @@
+package data
+
+// ConcurrencyOptions choose between the peak only and the full occupancy
+// timeline.
+type ConcurrencyOptions struct {
+	Timeline bool `json:"timeline,omitempty"`
+	OverlapOptions
+}
+
+type ConcurrencyRequest struct {
+	Ranges []IdentifiedRange `json:"ranges" binding:"required,min=1,dive"`
+	ConcurrencyOptions
+}
+
+// OccupancySegment is a stretch of time during which the same ranges are
+// active. Indexes point into the request list, in order, and IDs lists the IDs
+// of those ranges that have one. Segments never share an instant: under a
+// closed boundary the instant at which touching ranges meet is a segment of
+// its own, with an equal start and end, and the segments either side of it
+// leave it out. A segment whose ends differ from the boundary of the result
+// carries its own in Range.
+type OccupancySegment struct {
+	Range   DateRange `json:"range"`
+	Count   int       `json:"count"`
+	Indexes []int     `json:"indexes"`
+	IDs     []string  `json:"ids,omitempty"`
+}
+
+// ConcurrencyResult reports the highest number of ranges active at once and
+// every segment at which it is reached. Timeline, when asked for, is the
+// active count as a step function from the first start to the last end,
+// including the segments where nothing is active.
+type ConcurrencyResult struct {
+	Peak         int                `json:"peak"`
+	PeakSegments []OccupancySegment `json:"peak_segments"`
+	Timeline     []OccupancySegment `json:"timeline,omitempty"`
+	Boundary     Boundary           `json:"boundary"`
+}
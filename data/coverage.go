@@ -0,0 +1,18 @@
+package data
+
+// CoverageRequest asks whether Ranges cover every instant of Target.
+type CoverageRequest struct {
+	Target *DateRange        `json:"target" binding:"required"`
+	Ranges []IdentifiedRange `json:"ranges" binding:"dive"`
+	OverlapOptions
+}
+
+// CoverageResult says whether the target is covered without gaps. Gaps are the
+// uncovered parts of the target in start order, and Duplicated the parts of it
+// covered by more than one range, with the ranges involved.
+type CoverageResult struct {
+	Complete   bool               `json:"complete"`
+	Gaps       RangeSet           `json:"gaps"`
+	Duplicated []OccupancySegment `json:"duplicated"`
+	Boundary   Boundary           `json:"boundary"`
+}
@@ -1,13 +1,108 @@
 package data
 
-import "time"
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
 
 type OverlapRequest struct {
-	Range1 DateRange `json:"range1" binding:"required"`
-	Range2 DateRange `json:"range2" binding:"required"`
+	Range1 DateRange `json:"range1" binding:"required,bounded"`
+	Range2 DateRange `json:"range2" binding:"required,bounded"`
+	OverlapOptions
 }
 
+// DateRange is a span of time. A zero Start means the range has no lower
+// bound and a zero End means it has no upper bound; over JSON a missing bound
+// is written as null. Padding, when set, overrides the padding of the request
+// for this range. Boundary is only set on ranges the service returns whose
+// ends differ from the boundary of the result, such as the pieces a
+// difference leaves; requests cannot set it.
 type DateRange struct {
-	Start time.Time `json:"start" binding:"required"`
-	End   time.Time `json:"end" binding:"required"`
+	Start    time.Time `json:"start"`
+	End      time.Time `json:"end"`
+	Padding  Padding   `json:"padding"`
+	Boundary Boundary  `json:"boundary,omitempty"`
+}
+
+// HasStart reports whether the range has a lower bound.
+func (r DateRange) HasStart() bool {
+	return !r.Start.IsZero()
+}
+
+// HasEnd reports whether the range has an upper bound.
+func (r DateRange) HasEnd() bool {
+	return !r.End.IsZero()
+}
+
+// Duration returns the length of the range, or InfiniteDuration when a bound is missing.
+func (r DateRange) Duration() Duration {
+	if !r.HasStart() || !r.HasEnd() {
+		return InfiniteDuration
+	}
+	return Duration(r.End.Sub(r.Start))
+}
+
+type dateRangeJSON struct {
+	Start    *time.Time `json:"start"`
+	End      *time.Time `json:"end"`
+	Padding  *Padding   `json:"padding,omitempty"`
+	Boundary Boundary   `json:"boundary,omitempty"`
+}
+
+func (r DateRange) MarshalJSON() ([]byte, error) {
+	var out dateRangeJSON
+	if r.HasStart() {
+		out.Start = &r.Start
+	}
+	if r.HasEnd() {
+		out.End = &r.End
+	}
+	if !r.Padding.IsZero() {
+		out.Padding = &r.Padding
+	}
+	out.Boundary = r.Boundary
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON requires both keys to be present so that a typo is not read
+// as an open-ended range; an explicit null marks the missing bound. The zero
+// time.Time stands for a missing bound, so 0001-01-01T00:00:00Z cannot be
+// sent as a bound of its own.
+func (r *DateRange) UnmarshalJSON(b []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	if raw == nil {
+		return errors.New("date range must be an object with start and end")
+	}
+	if _, ok := raw["start"]; !ok {
+		return errors.New("date range start is required, use null for a range without a lower bound")
+	}
+	if _, ok := raw["end"]; !ok {
+		return errors.New("date range end is required, use null for a range without an upper bound")
+	}
+	if _, ok := raw["boundary"]; ok {
+		return errors.New("date range boundary cannot be set, the boundary of the request applies")
+	}
+
+	var in dateRangeJSON
+	if err := json.Unmarshal(b, &in); err != nil {
+		return err
+	}
+	if in.Start != nil && in.Start.IsZero() || in.End != nil && in.End.IsZero() {
+		return errors.New("date range bound 0001-01-01T00:00:00Z is not supported, use null for a missing bound")
+	}
+	*r = DateRange{}
+	if in.Start != nil {
+		r.Start = *in.Start
+	}
+	if in.End != nil {
+		r.End = *in.End
+	}
+	if in.Padding != nil {
+		r.Padding = *in.Padding
+	}
+	return nil
 }
@@ -0,0 +1,158 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal is an exact, arbitrary-precision number such as "0.0725" or
+// "12345678901234567890.5". Over JSON it is read from a string or a number
+// and always written as a string, so no digits are lost to floating point.
+// The zero value is 0.
+type Decimal struct {
+	rat *big.Rat
+}
+
+// NewDecimal returns the decimal equal to r.
+func NewDecimal(r *big.Rat) Decimal {
+	return Decimal{rat: new(big.Rat).Set(r)}
+}
+
+// maxDecimalDigits and maxDecimalExponent bound the decimals ParseDecimal
+// accepts, so a short input such as "1e999999999" cannot ask for a number
+// that takes minutes and gigabytes to build.
+const (
+	maxDecimalDigits   = 1000
+	maxDecimalExponent = 1000
+)
+
+// ParseDecimal reads a decimal such as "-12.50" or "1e-3". A fraction such as
+// "1/3" is accepted too, since that is how results that do not end are written.
+// At most maxDecimalDigits digits and an exponent of at most maxDecimalExponent
+// either way are accepted.
+func ParseDecimal(s string) (Decimal, error) {
+	s = strings.TrimSpace(s)
+	mantissa, exponent := strings.ToLower(s), ""
+	if i := strings.LastIndexAny(mantissa, "ep"); i >= 0 && !strings.Contains(mantissa, "/") {
+		mantissa, exponent = mantissa[:i], mantissa[i+1:]
+	}
+	if countDigits(mantissa) > maxDecimalDigits {
+		return Decimal{}, fmt.Errorf("%q has more than %d digits", s, maxDecimalDigits)
+	}
+	// Atoi clamps an exponent out of range of int, and gives 0 for one that
+	// is not a number at all, which SetString then rejects.
+	if e, _ := strconv.Atoi(exponent); e > maxDecimalExponent || e < -maxDecimalExponent {
+		return Decimal{}, fmt.Errorf("%q has an exponent beyond %d either way", s, maxDecimalExponent)
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return Decimal{}, fmt.Errorf("%q is not a decimal number", s)
+	}
+	return Decimal{rat: r}, nil
+}
+
+// countDigits counts the digits of s, hexadecimal ones included.
+func countDigits(s string) int {
+	n := 0
+	for _, c := range s {
+		if c >= '0' && c <= '9' || c >= 'a' && c <= 'f' {
+			n++
+		}
+	}
+	return n
+}
+
+// Rat returns the value as a new big.Rat.
+func (d Decimal) Rat() *big.Rat {
+	if d.rat == nil {
+		return new(big.Rat)
+	}
+	return new(big.Rat).Set(d.rat)
+}
+
+// Cmp returns -1, 0 or +1 as d is less than, equal to or greater than o.
+func (d Decimal) Cmp(o Decimal) int {
+	return d.Rat().Cmp(o.Rat())
+}
+
+// Add returns d + o.
+func (d Decimal) Add(o Decimal) Decimal {
+	return Decimal{rat: new(big.Rat).Add(d.Rat(), o.Rat())}
+}
+
+// Sub returns d - o.
+func (d Decimal) Sub(o Decimal) Decimal {
+	return Decimal{rat: new(big.Rat).Sub(d.Rat(), o.Rat())}
+}
+
+// Mul returns d * o.
+func (d Decimal) Mul(o Decimal) Decimal {
+	return Decimal{rat: new(big.Rat).Mul(d.Rat(), o.Rat())}
+}
+
+// String writes the value with as many decimal places as it needs. A value
+// with no finite decimal form, such as one third, is written as "1/3".
+func (d Decimal) String() string {
+	r := d.Rat()
+	if r.IsInt() {
+		return r.Num().String()
+	}
+	places, ok := decimalPlaces(r.Denom())
+	if !ok {
+		return r.String()
+	}
+	return r.FloatString(places)
+}
+
+// decimalPlaces returns how many digits after the point a fraction with the
+// given reduced denominator needs, or false when its expansion never ends.
+// The factors of 2 are the trailing zero bits. The factors of 5 come off by
+// powers 5, 5², 5⁴, ... while they divide, and then by the same powers in
+// reverse, so a denominator such as 10^80000 takes a few dozen divisions
+// rather than one per digit.
+func decimalPlaces(denom *big.Int) (int, bool) {
+	twos := int(denom.TrailingZeroBits())
+	d := new(big.Int).Rsh(denom, uint(twos))
+
+	q, rem := new(big.Int), new(big.Int)
+	powers := []*big.Int{big.NewInt(5)}
+	fives := 0
+	for {
+		k := len(powers) - 1
+		if q.QuoRem(d, powers[k], rem); rem.Sign() != 0 {
+			break
+		}
+		d.Set(q)
+		fives += 1 << k
+		powers = append(powers, new(big.Int).Mul(powers[k], powers[k]))
+	}
+	for k := len(powers) - 2; k >= 0; k-- {
+		if q.QuoRem(d, powers[k], rem); rem.Sign() == 0 {
+			d.Set(q)
+			fives += 1 << k
+		}
+	}
+	return max(twos, fives), d.Cmp(big.NewInt(1)) == 0
+}
+
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *Decimal) UnmarshalJSON(b []byte) error {
+	s := string(b)
+	if strings.HasPrefix(s, `"`) {
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+	}
+	parsed, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
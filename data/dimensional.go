@@ -0,0 +1,41 @@
+package data
+
+// Dimension is one extra axis of a DimensionalRecord besides its time range:
+// either a numeric Range, such as a weight bracket, or a set of discrete
+// Values, such as jurisdiction codes. Exactly one of them is set.
+type Dimension struct {
+	Range  *DecimalRange `json:"range,omitempty"`
+	Values []string      `json:"values,omitempty"`
+}
+
+// DimensionalRecord is a time range that also covers a region of named
+// dimensions. A record that leaves a dimension out applies to all of it.
+type DimensionalRecord struct {
+	ID         string               `json:"id,omitempty"`
+	Range      DateRange            `json:"range" binding:"required,bounded"`
+	Dimensions map[string]Dimension `json:"dimensions,omitempty" binding:"omitempty,dive"`
+}
+
+type DimensionalOverlapRequest struct {
+	Records []DimensionalRecord `json:"records" binding:"required,min=1,dive"`
+	OverlapOptions
+}
+
+// DimensionalConflict is a pair of records that intersect in time and in every
+// dimension. Dimensions holds the shared region of each dimension named by
+// either record. Indexes point into the request list and FirstIndex is always
+// lower than SecondIndex.
+type DimensionalConflict struct {
+	FirstIndex   int                  `json:"first_index"`
+	FirstID      string               `json:"first_id,omitempty"`
+	SecondIndex  int                  `json:"second_index"`
+	SecondID     string               `json:"second_id,omitempty"`
+	Intersection DateRange            `json:"intersection"`
+	Duration     Duration             `json:"duration"`
+	Dimensions   map[string]Dimension `json:"dimensions,omitempty"`
+}
+
+type DimensionalOverlapResult struct {
+	Conflicts []DimensionalConflict `json:"conflicts"`
+	Boundary  Boundary              `json:"boundary"`
+}
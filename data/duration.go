@@ -0,0 +1,43 @@
+package data
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Duration wraps time.Duration so that it travels over JSON as a Go duration
+// string such as "1h30m0s" instead of a raw nanosecond count.
+type Duration time.Duration
+
+// InfiniteDuration is the length of a range with a missing bound. It is
+// written to JSON as null, and requests cannot send it.
+const InfiniteDuration = Duration(math.MaxInt64)
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	if d == InfiniteDuration {
+		return []byte("null"), nil
+	}
+	return json.Marshal(time.Duration(d).String())
+}
+
+// UnmarshalJSON reads a duration string. null only ever marks the length of
+// an unbounded range in a response, so it is rejected rather than read as an
+// endless duration that every minimum would accept.
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return errors.New("duration must be a string such as \"15m\", not null")
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("duration must be a string such as \"15m\": %w", err)
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
@@ -0,0 +1,53 @@
+package data
+
+// FilingFrequency is how often returns are filed.
+type FilingFrequency string
+
+const (
+	FilingMonthly    FilingFrequency = "monthly"
+	FilingQuarterly  FilingFrequency = "quarterly"
+	FilingSemiAnnual FilingFrequency = "semi_annual"
+	FilingAnnual     FilingFrequency = "annual"
+)
+
+// FilingOptions describe a filing calendar. FiscalYearStart is the month, 1 to
+// 12, in which the fiscal year begins, January by default; quarters, halves
+// and years are counted from it. Periods begin at midnight in Zone, UTC by
+// default. Only the boundary of the overlap options is used.
+type FilingOptions struct {
+	Frequency       FilingFrequency `json:"frequency" binding:"required,oneof=monthly quarterly semi_annual annual"`
+	FiscalYearStart int             `json:"fiscal_year_start,omitempty" binding:"omitempty,min=1,max=12"`
+	Zone            string          `json:"zone,omitempty"`
+	OverlapOptions
+}
+
+// FilingPeriodRequest asks which filing periods Range touches.
+type FilingPeriodRequest struct {
+	Range DateRange `json:"range" binding:"required,bounded"`
+	FilingOptions
+}
+
+// FilingPeriod is one filing period that the range touches. Label names the
+// period, such as "2025-03", "2025-Q1", "2025-H1" or "2025"; with a fiscal
+// year that does not start in January it is prefixed with the fiscal year,
+// such as "FY2026-Q1", where a fiscal year is named after the calendar year
+// it ends in. Days counts the calendar days of the period that the range
+// touches, PeriodDays all of the period's days.
+type FilingPeriod struct {
+	Label        string    `json:"label"`
+	FiscalYear   int       `json:"fiscal_year"`
+	Period       DateRange `json:"period"`
+	Intersection DateRange `json:"intersection"`
+	Duration     Duration  `json:"duration"`
+	Days         int       `json:"days"`
+	PeriodDays   int       `json:"period_days"`
+}
+
+// FilingPeriodResult lists the periods in order. Days is the number of
+// calendar days the range touches.
+type FilingPeriodResult struct {
+	Periods   []FilingPeriod  `json:"periods"`
+	Days      int             `json:"days"`
+	Frequency FilingFrequency `json:"frequency"`
+	Boundary  Boundary        `json:"boundary"`
+}
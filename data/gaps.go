@@ -0,0 +1,20 @@
+package data
+
+// GapOptions filters the free gaps returned for a window. A zero MinGap keeps
+// every gap and a zero Limit returns all of them.
+type GapOptions struct {
+	MinGap Duration `json:"min_gap,omitempty" binding:"min=0"`
+	Limit  int      `json:"limit,omitempty" binding:"min=0"`
+	OverlapOptions
+}
+
+type GapRequest struct {
+	Window *DateRange  `json:"window" binding:"required"`
+	Busy   []DateRange `json:"busy" binding:"dive"`
+	GapOptions
+}
+
+type GapResult struct {
+	Gaps     RangeSet `json:"gaps"`
+	Boundary Boundary `json:"boundary"`
+}
@@ -0,0 +1,49 @@
+package data
+
+// JoinMode says which rows an interval join reports.
+type JoinMode string
+
+const (
+	// JoinInner reports only the overlapping left-right pairs. It is the default.
+	JoinInner JoinMode = "inner"
+	// JoinLeftOuter also reports every left range that matched nothing.
+	JoinLeftOuter JoinMode = "left_outer"
+)
+
+type JoinOptions struct {
+	Mode JoinMode `json:"mode,omitempty" binding:"omitempty,oneof=inner left_outer"`
+	OverlapOptions
+}
+
+// JoinRequest matches every range of Left, such as transaction service
+// periods, against the ranges of Right, such as rate periods.
+type JoinRequest struct {
+	Left  []IdentifiedRange `json:"left" binding:"required,dive"`
+	Right []IdentifiedRange `json:"right" binding:"required,dive"`
+	JoinOptions
+}
+
+// JoinRow is one row of an interval join: a left range with a right range it
+// overlaps, or, in left_outer mode, a left range that matched nothing, whose
+// right_index and intersection are null.
+type JoinRow struct {
+	LeftIndex    int        `json:"left_index"`
+	LeftID       string     `json:"left_id,omitempty"`
+	RightIndex   *int       `json:"right_index"`
+	RightID      string     `json:"right_id,omitempty"`
+	Intersection *DateRange `json:"intersection"`
+	Duration     Duration   `json:"duration"`
+}
+
+// JoinSummary counts the rows a join produced.
+type JoinSummary struct {
+	Matched   int      `json:"matched"`
+	Unmatched int      `json:"unmatched"`
+	Boundary  Boundary `json:"boundary"`
+}
+
+// JoinTrailer is the last line of a streamed join. A stream that ends without
+// it was cut short.
+type JoinTrailer struct {
+	Summary JoinSummary `json:"summary"`
+}
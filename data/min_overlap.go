@@ -0,0 +1,23 @@
+package data
+
+// MinOverlap is the smallest overlap that still counts as one; anything
+// shorter is reported as no overlap. Duration is an absolute length and
+// Percent a share (0..100) of the ranges named by Of. Both must be met when
+// both are set.
+type MinOverlap struct {
+	Duration Duration     `json:"duration,omitempty" binding:"min=0"`
+	Percent  float64      `json:"percent,omitempty" binding:"min=0,max=100"`
+	Of       MinOverlapOf `json:"of,omitempty" binding:"omitempty,oneof=either both range1 range2"`
+}
+
+// MinOverlapOf says which ranges a MinOverlap percentage is measured against.
+type MinOverlapOf string
+
+const (
+	// MinOverlapOfEither is met when the overlap is a large enough share of
+	// at least one of the ranges. It is the default.
+	MinOverlapOfEither MinOverlapOf = "either"
+	MinOverlapOfBoth   MinOverlapOf = "both"
+	MinOverlapOfRange1 MinOverlapOf = "range1"
+	MinOverlapOfRange2 MinOverlapOf = "range2"
+)
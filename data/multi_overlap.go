@@ -0,0 +1,36 @@
+package data
+
+// IdentifiedRange is a DateRange with an optional caller-supplied ID, used
+// wherever a request carries a list of ranges.
+type IdentifiedRange struct {
+	ID    string    `json:"id,omitempty"`
+	Range DateRange `json:"range" binding:"required,bounded"`
+}
+
+type MultiOverlapRequest struct {
+	Ranges []IdentifiedRange `json:"ranges" binding:"required,min=1,dive"`
+	OverlapOptions
+}
+
+// OverlapPair is one pair of overlapping ranges. Indexes point into the
+// request list and FirstIndex is always lower than SecondIndex. With padding,
+// RawIntersection is the overlap of the unpadded ranges, nil for a near miss.
+type OverlapPair struct {
+	FirstIndex      int        `json:"first_index"`
+	FirstID         string     `json:"first_id,omitempty"`
+	SecondIndex     int        `json:"second_index"`
+	SecondID        string     `json:"second_id,omitempty"`
+	Intersection    DateRange  `json:"intersection"`
+	RawIntersection *DateRange `json:"raw_intersection,omitempty"`
+	Duration        Duration   `json:"duration"`
+	// FirstCoverage and SecondCoverage are the share (0..1) of each range
+	// covered by the intersection, as in OverlapResult.
+	FirstCoverage  float64 `json:"first_coverage"`
+	SecondCoverage float64 `json:"second_coverage"`
+	Jaccard        float64 `json:"jaccard"`
+}
+
+type MultiOverlapResult struct {
+	Pairs    []OverlapPair `json:"pairs"`
+	Boundary Boundary      `json:"boundary"`
+}
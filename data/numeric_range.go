@@ -0,0 +1,89 @@
+package data
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// NumericRange is a span of numbers, such as a weight bracket or a block of
+// ZIP codes. A nil Start means the range has no lower bound and a nil End
+// means it has no upper bound; over JSON a missing bound is written as null.
+type NumericRange[T any] struct {
+	Start *T `json:"start"`
+	End   *T `json:"end"`
+}
+
+// IntRange is a range of whole numbers.
+type IntRange = NumericRange[int64]
+
+// DecimalRange is a range of arbitrary-precision decimals.
+type DecimalRange = NumericRange[Decimal]
+
+// UnmarshalJSON requires both keys to be present so that a typo is not read
+// as an open-ended range; an explicit null marks the missing bound.
+func (r *NumericRange[T]) UnmarshalJSON(b []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	if raw == nil {
+		return errors.New("range must be an object with start and end")
+	}
+	start, ok := raw["start"]
+	if !ok {
+		return errors.New("range start is required, use null for a range without a lower bound")
+	}
+	end, ok := raw["end"]
+	if !ok {
+		return errors.New("range end is required, use null for a range without an upper bound")
+	}
+
+	*r = NumericRange[T]{}
+	if err := json.Unmarshal(start, &r.Start); err != nil {
+		return err
+	}
+	return json.Unmarshal(end, &r.End)
+}
+
+type IdentifiedNumericRange[T any] struct {
+	ID    string          `json:"id,omitempty"`
+	Range NumericRange[T] `json:"range" binding:"required"`
+}
+
+type NumericOverlapRequest[T any] struct {
+	Range1   NumericRange[T] `json:"range1" binding:"required"`
+	Range2   NumericRange[T] `json:"range2" binding:"required"`
+	Boundary Boundary        `json:"boundary,omitempty"`
+}
+
+// NumericOverlapResult describes how two numeric ranges overlap. Length is the
+// number of integers in an integer intersection, counting its ends when the
+// boundary closes them, and the distance across a decimal one. It is null
+// when there is no intersection or it is unbounded.
+type NumericOverlapResult[T any] struct {
+	Overlap      bool             `json:"overlap"`
+	Intersection *NumericRange[T] `json:"intersection,omitempty"`
+	Length       *T               `json:"length"`
+	Relation     Relation         `json:"relation"`
+	Boundary     Boundary         `json:"boundary"`
+}
+
+type NumericPairsRequest[T any] struct {
+	Ranges   []IdentifiedNumericRange[T] `json:"ranges" binding:"required,min=1,dive"`
+	Boundary Boundary                    `json:"boundary,omitempty"`
+}
+
+type NumericPair[T any] struct {
+	FirstIndex   int             `json:"first_index"`
+	FirstID      string          `json:"first_id,omitempty"`
+	SecondIndex  int             `json:"second_index"`
+	SecondID     string          `json:"second_id,omitempty"`
+	Intersection NumericRange[T] `json:"intersection"`
+}
+
+// NumericPairsResult lists every overlapping pair of numeric ranges, ordered
+// by where their intersection starts.
+type NumericPairsResult[T any] struct {
+	Pairs    []NumericPair[T] `json:"pairs"`
+	Boundary Boundary         `json:"boundary"`
+}
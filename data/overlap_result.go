@@ -0,0 +1,21 @@
+package data
+
+// OverlapResult describes the intersection of two date ranges. When the ranges
+// do not overlap only Overlap is meaningful and Intersection is nil. With
+// padding, Intersection is the conflict window of the padded ranges and
+// RawIntersection the overlap of the ranges as given, nil for a near miss.
+type OverlapResult struct {
+	Overlap         bool       `json:"overlap"`
+	Intersection    *DateRange `json:"intersection,omitempty"`
+	RawIntersection *DateRange `json:"raw_intersection,omitempty"`
+	Duration        Duration   `json:"duration"`
+	// Range1Coverage and Range2Coverage are the share (0..1) of each input
+	// range that is covered by the intersection.
+	Range1Coverage float64 `json:"range1_coverage"`
+	Range2Coverage float64 `json:"range2_coverage"`
+	// Jaccard is the length of the intersection over the length of the union
+	// of both ranges: 1 for equal ranges, close to 0 for a brief overlap.
+	Jaccard float64 `json:"jaccard"`
+	// Boundary echoes the boundary mode the result was computed with.
+	Boundary Boundary `json:"boundary"`
+}
@@ -0,0 +1,13 @@
+package data
+
+// Padding widens a range by a buffer before its start and after its end, such
+// as a changeover time between bookings, so that ranges closer together than
+// the buffer count as overlapping.
+type Padding struct {
+	Before Duration `json:"before,omitempty" binding:"min=0"`
+	After  Duration `json:"after,omitempty" binding:"min=0"`
+}
+
+func (p Padding) IsZero() bool {
+	return p.Before == 0 && p.After == 0
+}
@@ -0,0 +1,40 @@
+package data
+
+// PartitionPolicy decides which of the records active in a segment apply to it.
+type PartitionPolicy string
+
+const (
+	// PartitionKeepAll applies every active record. It is the default.
+	PartitionKeepAll PartitionPolicy = "keep_all"
+	// PartitionLatestStart applies the record that took effect last.
+	PartitionLatestStart PartitionPolicy = "latest_start"
+	// PartitionHighestPriority applies the record with the highest priority.
+	PartitionHighestPriority PartitionPolicy = "highest_priority"
+)
+
+// PartitionRecord is an effective-dated record to partition. Priority is only
+// read by the highest_priority policy.
+type PartitionRecord struct {
+	ID       string    `json:"id,omitempty"`
+	Range    DateRange `json:"range" binding:"required,bounded"`
+	Priority int       `json:"priority,omitempty"`
+}
+
+type PartitionOptions struct {
+	Policy PartitionPolicy `json:"policy,omitempty" binding:"omitempty,oneof=keep_all latest_start highest_priority"`
+	OverlapOptions
+}
+
+type PartitionRequest struct {
+	Records []PartitionRecord `json:"records" binding:"required,min=1,dive"`
+	PartitionOptions
+}
+
+// PartitionResult splits the timeline of the records into ordered,
+// non-overlapping segments, each listing the records that apply to it.
+// Stretches without any record are left out.
+type PartitionResult struct {
+	Segments []OccupancySegment `json:"segments"`
+	Policy   PartitionPolicy    `json:"policy"`
+	Boundary Boundary           `json:"boundary"`
+}
@@ -0,0 +1,68 @@
+package data
+
+// DayCount is the convention by which the parts of a billing period are
+// measured.
+type DayCount string
+
+const (
+	// DayCountActualSeconds measures the exact elapsed time. It is the default.
+	DayCountActualSeconds DayCount = "actual_seconds"
+	// DayCountActualDays counts calendar days, ignoring the time of day.
+	DayCountActualDays DayCount = "actual_days"
+	// DayCountThirty360 counts every month as 30 days, with the 31st read as
+	// the 30th (30E/360), so the days of adjoining periods add up.
+	DayCountThirty360 DayCount = "thirty_360"
+)
+
+// RatePeriod is a value, such as a monthly price, that applies over a range.
+type RatePeriod struct {
+	ID    string    `json:"id,omitempty"`
+	Range DateRange `json:"range" binding:"required,bounded"`
+	Value *Decimal  `json:"value" binding:"required"`
+}
+
+// ProrationOptions pick the day count and, with Amount, a billed amount to
+// split across the rate periods. With Places the split amounts are rounded to
+// that many decimal places and still add up to the rounded total. Only the
+// boundary of the overlap options is used.
+type ProrationOptions struct {
+	DayCount DayCount `json:"day_count,omitempty" binding:"omitempty,oneof=actual_seconds actual_days thirty_360"`
+	Amount   *Decimal `json:"amount,omitempty"`
+	Places   *int     `json:"places,omitempty" binding:"omitempty,min=0,max=18"`
+	OverlapOptions
+}
+
+type ProrationRequest struct {
+	Period DateRange    `json:"period" binding:"required,bounded"`
+	Rates  []RatePeriod `json:"rates" binding:"required,min=1,dive"`
+	ProrationOptions
+}
+
+// ProrationShare is the part of the billing period that falls in one rate
+// period. Index points into the request list. Units are the seconds or days
+// of the intersection under the day count, Fraction its share of the billing
+// period and Weighted the fraction times the value of the rate.
+type ProrationShare struct {
+	Index        int       `json:"index"`
+	ID           string    `json:"id,omitempty"`
+	Intersection DateRange `json:"intersection"`
+	Units        Decimal   `json:"units"`
+	Fraction     Decimal   `json:"fraction"`
+	Value        Decimal   `json:"value"`
+	Weighted     Decimal   `json:"weighted"`
+	Amount       *Decimal  `json:"amount,omitempty"`
+}
+
+// ProrationResult lists a share for every rate period that overlaps the
+// billing period, in request order. Covered is the sum of the fractions, 1
+// when the rate periods cover the billing period once, and WeightedValue the
+// sum of the weighted values. Amount is the sum of the split amounts.
+type ProrationResult struct {
+	Shares        []ProrationShare `json:"shares"`
+	Units         Decimal          `json:"units"`
+	Covered       Decimal          `json:"covered"`
+	WeightedValue Decimal          `json:"weighted_value"`
+	Amount        *Decimal         `json:"amount,omitempty"`
+	DayCount      DayCount         `json:"day_count"`
+	Boundary      Boundary         `json:"boundary"`
+}
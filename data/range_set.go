@@ -0,0 +1,25 @@
+package data
+
+// RangeSet is a set of instants written as sorted, non-overlapping ranges.
+// Sets returned by the overlap service are always normalized this way.
+type RangeSet []DateRange
+
+// RangeSetRequest carries the two operands of a binary range-set operation.
+// For a union B may be left out.
+type RangeSetRequest struct {
+	A []DateRange `json:"a" binding:"required,dive"`
+	B []DateRange `json:"b" binding:"dive"`
+	OverlapOptions
+}
+
+// ComplementRequest asks for the parts of Window not covered by Set.
+type ComplementRequest struct {
+	Set    []DateRange `json:"set" binding:"dive"`
+	Window *DateRange  `json:"window" binding:"required"`
+	OverlapOptions
+}
+
+type RangeSetResult struct {
+	Ranges   RangeSet `json:"ranges"`
+	Boundary Boundary `json:"boundary"`
+}
@@ -0,0 +1,59 @@
+package data
+
+import "time"
+
+// RecurringRange is a series of ranges in the style of an RFC 5545 event: the
+// first occurrence starts at DTStart and lasts Duration, RRule repeats it, and
+// RDates add or ExDates remove individual occurrence starts.
+type RecurringRange struct {
+	DTStart  time.Time   `json:"dtstart" binding:"required"`
+	Duration Duration    `json:"duration" binding:"required,gt=0"`
+	RRule    string      `json:"rrule,omitempty"`
+	ExDates  []time.Time `json:"exdate,omitempty"`
+	RDates   []time.Time `json:"rdate,omitempty"`
+}
+
+// Schedule is either a fixed range or a recurring series; exactly one of the
+// two must be set.
+type Schedule struct {
+	Range     *DateRange      `json:"range,omitempty"`
+	Recurring *RecurringRange `json:"recurring,omitempty"`
+}
+
+// RecurrenceOptions tune a recurring overlap search. Window, when set, bounds
+// the search and Limit caps the number of clashes returned.
+type RecurrenceOptions struct {
+	Window *DateRange `json:"window,omitempty"`
+	Limit  int        `json:"limit,omitempty" binding:"min=0"`
+	OverlapOptions
+}
+
+type RecurringOverlapRequest struct {
+	Schedule1 Schedule `json:"schedule1" binding:"required"`
+	Schedule2 Schedule `json:"schedule2" binding:"required"`
+	RecurrenceOptions
+}
+
+// OccurrenceClash is one pair of overlapping occurrences, as given. With
+// padding, Intersection is the conflict window of the padded occurrences and
+// RawIntersection their overlap as given, nil for a near miss.
+type OccurrenceClash struct {
+	First           DateRange  `json:"first"`
+	Second          DateRange  `json:"second"`
+	Intersection    DateRange  `json:"intersection"`
+	RawIntersection *DateRange `json:"raw_intersection,omitempty"`
+	Duration        Duration   `json:"duration"`
+	FirstCoverage   float64    `json:"first_coverage"`
+	SecondCoverage  float64    `json:"second_coverage"`
+	Jaccard         float64    `json:"jaccard"`
+}
+
+// RecurringOverlapResult lists clashes in order of their intersection start.
+// Truncated is set when a series had to be cut off at the expansion limit, so
+// clashes beyond that point were not looked for.
+type RecurringOverlapResult struct {
+	Overlap   bool              `json:"overlap"`
+	Clashes   []OccurrenceClash `json:"clashes"`
+	Truncated bool              `json:"truncated"`
+	Boundary  Boundary          `json:"boundary"`
+}
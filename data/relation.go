@@ -0,0 +1,60 @@
+package data
+
+// Relation is one of Allen's thirteen interval relations, read as
+// "range1 <relation> range2".
+type Relation string
+
+const (
+	RelationBefore       Relation = "before"
+	RelationMeets        Relation = "meets"
+	RelationOverlaps     Relation = "overlaps"
+	RelationStarts       Relation = "starts"
+	RelationDuring       Relation = "during"
+	RelationFinishes     Relation = "finishes"
+	RelationEquals       Relation = "equals"
+	RelationAfter        Relation = "after"
+	RelationMetBy        Relation = "met_by"
+	RelationOverlappedBy Relation = "overlapped_by"
+	RelationStartedBy    Relation = "started_by"
+	RelationContains     Relation = "contains"
+	RelationFinishedBy   Relation = "finished_by"
+)
+
+func (r Relation) String() string {
+	return string(r)
+}
+
+// Inverse returns the relation seen from the other range, e.g. before -> after.
+func (r Relation) Inverse() Relation {
+	switch r {
+	case RelationBefore:
+		return RelationAfter
+	case RelationMeets:
+		return RelationMetBy
+	case RelationOverlaps:
+		return RelationOverlappedBy
+	case RelationStarts:
+		return RelationStartedBy
+	case RelationDuring:
+		return RelationContains
+	case RelationFinishes:
+		return RelationFinishedBy
+	case RelationAfter:
+		return RelationBefore
+	case RelationMetBy:
+		return RelationMeets
+	case RelationOverlappedBy:
+		return RelationOverlaps
+	case RelationStartedBy:
+		return RelationStarts
+	case RelationContains:
+		return RelationDuring
+	case RelationFinishedBy:
+		return RelationFinishes
+	}
+	return r
+}
+
+type RelationResult struct {
+	Relation Relation `json:"relation"`
+}
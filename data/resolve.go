@@ -0,0 +1,60 @@
+package data
+
+// EditKind names a change that removes a range from its conflicts.
+type EditKind string
+
+const (
+	// EditShiftLater moves the whole range later, keeping its length.
+	EditShiftLater EditKind = "shift_later"
+	// EditShiftEarlier moves the whole range earlier, keeping its length.
+	EditShiftEarlier EditKind = "shift_earlier"
+	// EditTrimStart moves the start of the range later.
+	EditTrimStart EditKind = "trim_start"
+	// EditTrimEnd moves the end of the range earlier.
+	EditTrimEnd EditKind = "trim_end"
+)
+
+// ResolvableRange is a range that may be edited to remove its conflicts,
+// unless it is fixed.
+type ResolvableRange struct {
+	ID    string    `json:"id,omitempty"`
+	Range DateRange `json:"range" binding:"required,bounded"`
+	Fixed bool      `json:"fixed,omitempty"`
+}
+
+// ResolveOptions limits the edits that may be suggested; all of them are
+// allowed when Edits is empty. An edited range may end where the next one
+// starts, or one nanosecond before it under "[]".
+type ResolveOptions struct {
+	Edits []EditKind `json:"edits,omitempty" binding:"omitempty,dive,oneof=shift_later shift_earlier trim_start trim_end"`
+	OverlapOptions
+}
+
+type ResolveRequest struct {
+	Ranges []ResolvableRange `json:"ranges" binding:"required,min=1,dive"`
+	ResolveOptions
+}
+
+// RangeEdit is one suggested change. Index points into the request list and
+// Cost is how far the range, or the trimmed bound, moves.
+type RangeEdit struct {
+	Index int       `json:"index"`
+	ID    string    `json:"id,omitempty"`
+	Kind  EditKind  `json:"kind"`
+	From  DateRange `json:"from"`
+	To    DateRange `json:"to"`
+	Cost  Duration  `json:"cost"`
+}
+
+// ResolutionResult lists the suggested edits and the ranges once they are
+// applied, in request order. Cost is the total of the edits, which are chosen
+// one range at a time and need not be the cheapest set overall. Resolved is false when some conflicts could not
+// be removed with the allowed edits; those are listed in Conflicts.
+type ResolutionResult struct {
+	Resolved  bool              `json:"resolved"`
+	Edits     []RangeEdit       `json:"edits"`
+	Ranges    []IdentifiedRange `json:"ranges"`
+	Cost      Duration          `json:"cost"`
+	Conflicts []OverlapPair     `json:"conflicts"`
+	Boundary  Boundary          `json:"boundary"`
+}
@@ -0,0 +1,44 @@
+package data
+
+// WorkingHours is a daily stretch in which slots may be booked, such as 09:00
+// to 17:00 on weekdays. Days lists the weekdays it applies to by lowercase
+// name; without Days it applies to every day.
+type WorkingHours struct {
+	Days  []string  `json:"days,omitempty" binding:"dive,oneof=sunday monday tuesday wednesday thursday friday saturday"`
+	Start ClockTime `json:"start"`
+	End   ClockTime `json:"end"`
+}
+
+// SlotOptions limit where slots may fall. Working hours are read in Zone,
+// which defaults to the zone of Calendar and then to UTC. With a Calendar,
+// its weekends and holidays are never free. A zero Limit returns every slot.
+type SlotOptions struct {
+	WorkingHours []WorkingHours `json:"working_hours,omitempty" binding:"dive"`
+	Calendar     string         `json:"calendar,omitempty"`
+	Zone         string         `json:"zone,omitempty"`
+	Limit        int            `json:"limit,omitempty" binding:"min=0"`
+	OverlapOptions
+}
+
+// SlotRequest looks for slots of Duration within Window in which no
+// participant is busy. Busy maps each participant's name to their busy ranges.
+type SlotRequest struct {
+	Busy     map[string][]DateRange `json:"busy" binding:"required,min=1,dive,dive"`
+	Duration Duration               `json:"duration" binding:"required,gt=0"`
+	Window   *DateRange             `json:"window" binding:"required"`
+	SlotOptions
+}
+
+// Slot is a candidate meeting slot. It starts as early as possible within
+// Free, the whole stretch in which every participant is available, so a
+// caller can move the slot within it.
+type Slot struct {
+	Range DateRange `json:"range"`
+	Free  DateRange `json:"free"`
+}
+
+// SlotResult lists the candidate slots, earliest first, one per free stretch.
+type SlotResult struct {
+	Slots    []Slot   `json:"slots"`
+	Boundary Boundary `json:"boundary"`
+}
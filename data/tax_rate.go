@@ -0,0 +1,75 @@
+package data
+
+// TaxRateRow is one sales-tax rate row: the rate a jurisdiction charges over
+// an effective period.
+type TaxRateRow struct {
+	ID           string    `json:"id,omitempty"`
+	Jurisdiction string    `json:"jurisdiction" binding:"required"`
+	Rate         *Decimal  `json:"rate" binding:"required"`
+	Range        DateRange `json:"range" binding:"required,bounded"`
+}
+
+// TaxRateValidationRequest asks for the problems in a file of rate rows. Each
+// jurisdiction should be covered without gaps over Window; without a window,
+// from the first start to the last end of its own rows.
+type TaxRateValidationRequest struct {
+	Rows   []TaxRateRow `json:"rows" binding:"required,min=1,dive"`
+	Window *DateRange   `json:"window,omitempty"`
+	OverlapOptions
+}
+
+// RateConflict is a pair of rows of one jurisdiction whose periods overlap
+// with different rates. Indexes point into the request list and FirstIndex is
+// always lower than SecondIndex.
+type RateConflict struct {
+	FirstIndex   int       `json:"first_index"`
+	FirstID      string    `json:"first_id,omitempty"`
+	FirstRate    Decimal   `json:"first_rate"`
+	SecondIndex  int       `json:"second_index"`
+	SecondID     string    `json:"second_id,omitempty"`
+	SecondRate   Decimal   `json:"second_rate"`
+	Intersection DateRange `json:"intersection"`
+	Duration     Duration  `json:"duration"`
+}
+
+// RateDuplicate is a pair of rows of one jurisdiction with the same rate and
+// the same period.
+type RateDuplicate struct {
+	FirstIndex  int       `json:"first_index"`
+	FirstID     string    `json:"first_id,omitempty"`
+	SecondIndex int       `json:"second_index"`
+	SecondID    string    `json:"second_id,omitempty"`
+	Range       DateRange `json:"range"`
+	Rate        Decimal   `json:"rate"`
+}
+
+// RateGap is a stretch in which a jurisdiction has no rate. BeforeIndex is the
+// row that ends where the gap starts and AfterIndex the row that starts where
+// it ends; either is null at the edge of the window.
+type RateGap struct {
+	Range       DateRange `json:"range"`
+	Duration    Duration  `json:"duration"`
+	BeforeIndex *int      `json:"before_index"`
+	BeforeID    string    `json:"before_id,omitempty"`
+	AfterIndex  *int      `json:"after_index"`
+	AfterID     string    `json:"after_id,omitempty"`
+}
+
+// JurisdictionReport lists the problems found in the rows of one
+// jurisdiction, whose indexes are given in Rows.
+type JurisdictionReport struct {
+	Jurisdiction string          `json:"jurisdiction"`
+	Valid        bool            `json:"valid"`
+	Rows         []int           `json:"rows"`
+	Conflicts    []RateConflict  `json:"conflicts"`
+	Gaps         []RateGap       `json:"gaps"`
+	Duplicates   []RateDuplicate `json:"duplicates"`
+}
+
+// TaxRateReport is valid when no jurisdiction has a problem. Jurisdictions
+// come in name order.
+type TaxRateReport struct {
+	Valid         bool                 `json:"valid"`
+	Jurisdictions []JurisdictionReport `json:"jurisdictions"`
+	Boundary      Boundary             `json:"boundary"`
+}
@@ -0,0 +1,137 @@
+package data
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// localLayout is how a LocalDateTime travels over JSON. Fractional seconds are
+// accepted on input.
+const localLayout = "2006-01-02T15:04:05"
+
+// LocalDateTime is a wall-clock date and time without a UTC offset, such as
+// "2025-03-09T09:00:00". It only names an instant together with a zone. The
+// wall-clock fields are kept in a UTC time.Time; the zero value is a missing
+// bound.
+type LocalDateTime time.Time
+
+// Wall returns the wall-clock fields as a UTC time.Time.
+func (l LocalDateTime) Wall() time.Time {
+	return time.Time(l)
+}
+
+func (l LocalDateTime) IsZero() bool {
+	return time.Time(l).IsZero()
+}
+
+func (l LocalDateTime) MarshalJSON() ([]byte, error) {
+	if l.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(time.Time(l).Format(localLayout + ".999999999"))
+}
+
+func (l *LocalDateTime) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		*l = LocalDateTime{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("local date-time must be a string such as \"2025-03-09T09:00:00\": %w", err)
+	}
+	if _, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return fmt.Errorf("local date-time %q must not carry a UTC offset, the zone says where it is", s)
+	}
+	t, err := time.Parse(localLayout, s)
+	if err != nil {
+		return fmt.Errorf("local date-time %q must look like 2025-03-09T09:00:00", s)
+	}
+	if t.IsZero() {
+		return fmt.Errorf("local date-time %q is not supported, use null for a missing bound", s)
+	}
+	*l = LocalDateTime(t)
+	return nil
+}
+
+// ZonedRange is a range of wall-clock times in a named IANA zone, such as
+// 09:00-17:00 in America/New_York. As with DateRange, a null bound leaves
+// that side open.
+type ZonedRange struct {
+	Start LocalDateTime `json:"start"`
+	End   LocalDateTime `json:"end"`
+	Zone  string        `json:"zone" binding:"required"`
+}
+
+// UnmarshalJSON requires both bounds to be present, like DateRange does.
+func (r *ZonedRange) UnmarshalJSON(b []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	if raw == nil {
+		return errors.New("zoned range must be an object with start, end and zone")
+	}
+	if _, ok := raw["start"]; !ok {
+		return errors.New("zoned range start is required, use null for a range without a lower bound")
+	}
+	if _, ok := raw["end"]; !ok {
+		return errors.New("zoned range end is required, use null for a range without an upper bound")
+	}
+
+	type plain ZonedRange
+	var in plain
+	if err := json.Unmarshal(b, &in); err != nil {
+		return err
+	}
+	*r = ZonedRange(in)
+	return nil
+}
+
+// GapPolicy says what to do with a wall-clock time that a DST change skips.
+type GapPolicy string
+
+const (
+	// GapShiftForward moves the time forward by the length of the gap, so
+	// 02:30 on a spring-forward night becomes 03:30.
+	GapShiftForward GapPolicy = "shift_forward"
+	GapReject       GapPolicy = "reject"
+)
+
+// FoldPolicy says which instant to pick for a wall-clock time that a DST
+// change repeats.
+type FoldPolicy string
+
+const (
+	FoldEarlier FoldPolicy = "earlier"
+	FoldLater   FoldPolicy = "later"
+	FoldReject  FoldPolicy = "reject"
+)
+
+// ZoneOptions control how zoned ranges are resolved and reported. OutputZone
+// defaults to the zone of range1, Nonexistent to shift_forward and Ambiguous
+// to earlier.
+type ZoneOptions struct {
+	OutputZone  string     `json:"output_zone,omitempty"`
+	Nonexistent GapPolicy  `json:"nonexistent,omitempty" binding:"omitempty,oneof=shift_forward reject"`
+	Ambiguous   FoldPolicy `json:"ambiguous,omitempty" binding:"omitempty,oneof=earlier later reject"`
+	OverlapOptions
+}
+
+type ZonedOverlapRequest struct {
+	Range1 ZonedRange `json:"range1" binding:"required"`
+	Range2 ZonedRange `json:"range2" binding:"required"`
+	ZoneOptions
+}
+
+// ZonedOverlapResult is an OverlapResult whose times are all given in
+// OutputZone. Range1 and Range2 are the inputs as resolved to instants, which
+// shows how DST changes were handled.
+type ZonedOverlapResult struct {
+	OverlapResult
+	Range1     DateRange `json:"range1"`
+	Range2     DateRange `json:"range2"`
+	OutputZone string    `json:"output_zone"`
+}
@@ -0,0 +1,431 @@
+package api
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/keshu12345/overlap-avalara/config"
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/internal/recurrence"
+	"github.com/keshu12345/overlap-avalara/internal/zone"
+)
+
+// rangeRules are the semantic checks applied to every date range that is
+// bound from a request body.
+type rangeRules struct {
+	rejectZeroLength bool
+	maxSpan          time.Duration
+	minYear          int
+	maxYear          int
+}
+
+func defaultRangeRules() rangeRules {
+	return rangeRules{minYear: 1900, maxYear: 2199}
+}
+
+func newRangeRules(cfg *config.Configuration) rangeRules {
+	rules := defaultRangeRules()
+	if cfg == nil {
+		return rules
+	}
+	rules.rejectZeroLength = cfg.Validation.RejectZeroLength
+	rules.maxSpan = cfg.Validation.MaxSpan
+	if cfg.Validation.MinYear != 0 {
+		rules.minYear = cfg.Validation.MinYear
+	}
+	if cfg.Validation.MaxYear != 0 {
+		rules.maxYear = cfg.Validation.MaxYear
+	}
+	return rules
+}
+
+// binderKey is the gin context key under which the handlers find the binder
+// of their router.
+const binderKey = "api.requestBinder"
+
+// requestBinder decodes and validates request bodies under the range rules of
+// one router. It keeps a validator of its own, so routers with different
+// rules neither share nor change gin's.
+type requestBinder struct {
+	rules    rangeRules
+	validate *validator.Validate
+}
+
+// newRequestBinder teaches a validator the rules that struct tags cannot
+// express, and makes it report fields by their JSON names.
+func newRequestBinder(cfg *config.Configuration) *requestBinder {
+	rules := newRangeRules(cfg)
+	v := validator.New()
+	v.SetTagName("binding")
+	v.RegisterTagNameFunc(jsonFieldName)
+	_ = v.RegisterValidation("bounded", boundedRange)
+	v.RegisterStructValidation(rules.validateDateRange, data.DateRange{})
+	v.RegisterStructValidation(validateSchedule, data.Schedule{})
+	v.RegisterStructValidation(rules.validateRecurringRange, data.RecurringRange{})
+	v.RegisterStructValidation(rules.validateZonedRange, data.ZonedRange{})
+	v.RegisterStructValidation(validateZoneOptions, data.ZoneOptions{})
+	v.RegisterStructValidation(rules.validateCivilDateRange, data.CivilDateRange{})
+	v.RegisterStructValidation(validateMixedRange, data.MixedRange{})
+	v.RegisterStructValidation(validateMixedOptions, data.MixedOptions{})
+	v.RegisterStructValidation(validateDimension, data.Dimension{})
+	v.RegisterStructValidation(validateWorkingHours, data.WorkingHours{})
+	v.RegisterStructValidation(validateSlotOptions, data.SlotOptions{})
+	v.RegisterStructValidation(validateFilingOptions, data.FilingOptions{})
+	v.RegisterStructValidation(validateNumericRange(cmp.Compare[int64]), data.IntRange{})
+	v.RegisterStructValidation(validateNumericRange(data.Decimal.Cmp), data.DecimalRange{})
+	return &requestBinder{rules: rules, validate: v}
+}
+
+// attach makes the binder available to the handlers of a route group.
+func (b *requestBinder) attach(c *gin.Context) {
+	c.Set(binderKey, b)
+	c.Next()
+}
+
+// bind decodes the JSON body into req and validates it.
+func (b *requestBinder) bind(c *gin.Context, req interface{}) error {
+	if c.Request == nil || c.Request.Body == nil {
+		return errors.New("invalid request")
+	}
+	if err := json.NewDecoder(c.Request.Body).Decode(req); err != nil {
+		return err
+	}
+	return b.validate.Struct(req)
+}
+
+func jsonFieldName(f reflect.StructField) string {
+	name := strings.SplitN(f.Tag.Get("json"), ",", 2)[0]
+	if name == "-" {
+		return ""
+	}
+	return name
+}
+
+// boundedRange backs the bounded tag, which requires a date range to have at
+// least one bound. A range with neither is what a range left out of the
+// request body decodes to, so the tag keeps such ranges from passing as
+// "always". Windows are pointers and members of lists are always written out,
+// so those may cover all time.
+func boundedRange(fl validator.FieldLevel) bool {
+	r, ok := fl.Field().Interface().(data.DateRange)
+	return !ok || r.HasStart() || r.HasEnd()
+}
+
+// validateDateRange runs the semantic range checks.
+func (rules rangeRules) validateDateRange(sl validator.StructLevel) {
+	rules.validateBounds(sl, sl.Current().Interface().(data.DateRange))
+}
+
+func (rules rangeRules) validateBounds(sl validator.StructLevel, r data.DateRange) {
+	if r.HasStart() && !rules.inEra(r.Start) {
+		sl.ReportError(r.Start, "start", "Start", "era", rules.era())
+	}
+	if r.HasEnd() && !rules.inEra(r.End) {
+		sl.ReportError(r.End, "end", "End", "era", rules.era())
+	}
+	if !r.HasStart() || !r.HasEnd() {
+		return
+	}
+
+	switch {
+	case r.End.Before(r.Start):
+		sl.ReportError(r.End, "end", "End", "inverted", "")
+	case r.End.Equal(r.Start) && rules.rejectZeroLength:
+		sl.ReportError(r.End, "end", "End", "zero_length", "")
+	case rules.maxSpan > 0 && r.End.Sub(r.Start) > rules.maxSpan:
+		sl.ReportError(r.End, "end", "End", "max_span", rules.maxSpan.String())
+	}
+}
+
+// validateSchedule requires exactly one of a fixed range or a recurring series.
+func validateSchedule(sl validator.StructLevel) {
+	s := sl.Current().Interface().(data.Schedule)
+	if (s.Range == nil) == (s.Recurring == nil) {
+		sl.ReportError(s, "", "", "schedule", "")
+	}
+}
+
+// validateRecurringRange checks that the RRULE parses and that the series
+// starts within the supported years.
+func (rules rangeRules) validateRecurringRange(sl validator.StructLevel) {
+	r := sl.Current().Interface().(data.RecurringRange)
+	if !r.DTStart.IsZero() && !rules.inEra(r.DTStart) {
+		sl.ReportError(r.DTStart, "dtstart", "DTStart", "era", rules.era())
+	}
+	if r.RRule != "" {
+		if _, err := recurrence.Parse(r.RRule); err != nil {
+			sl.ReportError(r.RRule, "rrule", "RRule", "rrule", err.Error())
+		}
+	}
+}
+
+// validateZonedRange applies the date range checks to the wall-clock times and
+// checks that the zone is known. Whether a time exists in the zone depends on
+// the request options, so that is left to the service.
+func (rules rangeRules) validateZonedRange(sl validator.StructLevel) {
+	r := sl.Current().Interface().(data.ZonedRange)
+	if r.Zone != "" {
+		if _, err := zone.Load(r.Zone); err != nil {
+			sl.ReportError(r.Zone, "zone", "Zone", "zone", "")
+		}
+	}
+	if r.Start.IsZero() && r.End.IsZero() {
+		sl.ReportError(r.Start, "start", "Start", "bounded", "")
+		return
+	}
+	rules.validateBounds(sl, data.DateRange{Start: r.Start.Wall(), End: r.End.Wall()})
+}
+
+func validateZoneOptions(sl validator.StructLevel) {
+	opts := sl.Current().Interface().(data.ZoneOptions)
+	if opts.OutputZone != "" {
+		if _, err := zone.Load(opts.OutputZone); err != nil {
+			sl.ReportError(opts.OutputZone, "output_zone", "OutputZone", "zone", "")
+		}
+	}
+}
+
+// validateCivilDateRange applies the date range checks to whole days. A
+// single-day range is never zero length, and its span counts the last day.
+func (rules rangeRules) validateCivilDateRange(sl validator.StructLevel) {
+	r := sl.Current().Interface().(data.CivilDateRange)
+
+	if r.Start.IsZero() && r.End.IsZero() {
+		sl.ReportError(r.Start, "start", "Start", "bounded", "")
+		return
+	}
+	if !r.Start.IsZero() && !rules.inEra(r.Start.Midnight()) {
+		sl.ReportError(r.Start, "start", "Start", "era", rules.era())
+	}
+	if !r.End.IsZero() && !rules.inEra(r.End.Midnight()) {
+		sl.ReportError(r.End, "end", "End", "era", rules.era())
+	}
+	if r.Start.IsZero() || r.End.IsZero() {
+		return
+	}
+
+	switch {
+	case r.End.Midnight().Before(r.Start.Midnight()):
+		sl.ReportError(r.End, "end", "End", "inverted", "")
+	case rules.maxSpan > 0 && time.Duration(*r.Days())*24*time.Hour > rules.maxSpan:
+		sl.ReportError(r.End, "end", "End", "max_span", rules.maxSpan.String())
+	}
+}
+
+// validateNumericRange rejects numeric ranges with neither bound, like
+// validateDateRange, and ranges that end before they start.
+func validateNumericRange[T any](compare func(a, b T) int) validator.StructLevelFunc {
+	return func(sl validator.StructLevel) {
+		r := sl.Current().Interface().(data.NumericRange[T])
+		switch {
+		case r.Start == nil && r.End == nil:
+			sl.ReportError(r.Start, "start", "Start", "bounded", "")
+		case r.Start != nil && r.End != nil && compare(*r.End, *r.Start) < 0:
+			sl.ReportError(r.End, "end", "End", "inverted", "")
+		}
+	}
+}
+
+// validateDimension requires exactly one of a range or a non-empty set of values.
+func validateDimension(sl validator.StructLevel) {
+	d := sl.Current().Interface().(data.Dimension)
+	switch {
+	case (d.Range == nil) == (d.Values == nil):
+		sl.ReportError(d, "", "", "dimension", "")
+	case d.Values != nil && len(d.Values) == 0:
+		sl.ReportError(d.Values, "values", "Values", "min", "1")
+	}
+}
+
+// validateWorkingHours requires the working hours to end after they start.
+func validateWorkingHours(sl validator.StructLevel) {
+	h := sl.Current().Interface().(data.WorkingHours)
+	if h.End <= h.Start {
+		sl.ReportError(h.End, "end", "End", "zero_length", "")
+	}
+}
+
+func validateSlotOptions(sl validator.StructLevel) {
+	opts := sl.Current().Interface().(data.SlotOptions)
+	if opts.Zone != "" {
+		if _, err := zone.Load(opts.Zone); err != nil {
+			sl.ReportError(opts.Zone, "zone", "Zone", "zone", "")
+		}
+	}
+}
+
+func validateFilingOptions(sl validator.StructLevel) {
+	opts := sl.Current().Interface().(data.FilingOptions)
+	if opts.Zone != "" {
+		if _, err := zone.Load(opts.Zone); err != nil {
+			sl.ReportError(opts.Zone, "zone", "Zone", "zone", "")
+		}
+	}
+}
+
+func validateMixedRange(sl validator.StructLevel) {
+	r := sl.Current().Interface().(data.MixedRange)
+	if (r.Range == nil) == (r.Dates == nil) {
+		sl.ReportError(r, "", "", "mixed", "")
+	}
+}
+
+func validateMixedOptions(sl validator.StructLevel) {
+	opts := sl.Current().Interface().(data.MixedOptions)
+	if opts.Zone != "" {
+		if _, err := zone.Load(opts.Zone); err != nil {
+			sl.ReportError(opts.Zone, "zone", "Zone", "zone", "")
+		}
+	}
+}
+
+func (r rangeRules) inEra(t time.Time) bool {
+	year := t.UTC().Year()
+	return year >= r.minYear && year <= r.maxYear
+}
+
+func (r rangeRules) era() string {
+	return fmt.Sprintf("%d-%d", r.minYear, r.maxYear)
+}
+
+// fieldErrors turns validator errors on req into messages keyed by the JSON
+// path of the offending field, e.g. "range1.end" or "ranges[2].range.start".
+// It returns nil for errors that did not come from the validator.
+func fieldErrors(req interface{}, err error) map[string]string {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return nil
+	}
+	fields := make(map[string]string, len(validationErrors))
+	for _, fe := range validationErrors {
+		fields[jsonPath(reflect.TypeOf(req), fe.StructNamespace())] = fieldMessage(fe)
+	}
+	return fields
+}
+
+// jsonPath follows the Go namespace of a field, such as
+// "GapRequest.GapOptions.Limit", from t down to the field and returns its path
+// in the JSON body, "limit". The request struct and embedded structs have no
+// name of their own in the body and are left out.
+func jsonPath(t reflect.Type, namespace string) string {
+	segments := splitNamespace(namespace)
+	path := make([]string, 0, len(segments))
+	for _, s := range segments[1:] {
+		if s == "" {
+			continue
+		}
+		name, index := s, ""
+		if i := strings.IndexByte(s, '['); i >= 0 {
+			name, index = s[:i], s[i:]
+		}
+		for t != nil && t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		if t == nil || t.Kind() != reflect.Struct {
+			path, t = append(path, s), nil
+			continue
+		}
+		f, ok := t.FieldByName(name)
+		if !ok {
+			path, t = append(path, s), nil
+			continue
+		}
+		t = f.Type
+		for range indexes(index) {
+			for t.Kind() == reflect.Pointer {
+				t = t.Elem()
+			}
+			t = t.Elem()
+		}
+		if !f.Anonymous {
+			if tag := jsonFieldName(f); tag != "" {
+				name = tag
+			}
+			path = append(path, name+index)
+		}
+	}
+	return strings.Join(path, ".")
+}
+
+// indexes counts the bracketed indexes or map keys in s, such as "[2]" or
+// "[alice][0]".
+func indexes(s string) int {
+	n, depth := 0, 0
+	for _, r := range s {
+		switch r {
+		case '[':
+			if depth == 0 {
+				n++
+			}
+			depth++
+		case ']':
+			depth--
+		}
+	}
+	return n
+}
+
+// splitNamespace splits a namespace at the dots outside brackets, as the
+// name of a generic request struct lists its type arguments with their
+// package paths, e.g. "NumericPairsRequest[example.com/data.Decimal]".
+func splitNamespace(namespace string) []string {
+	var segments []string
+	depth, start := 0, 0
+	for i, r := range namespace {
+		switch r {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case '.':
+			if depth == 0 {
+				segments = append(segments, namespace[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(segments, namespace[start:])
+}
+
+func fieldMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "gt":
+		return "must be greater than " + fe.Param()
+	case "min":
+		return "must be at least " + fe.Param()
+	case "max":
+		return "must be at most " + fe.Param()
+	case "bounded":
+		return "range needs a start or an end"
+	case "era":
+		return "must fall within the supported years " + fe.Param()
+	case "inverted":
+		return "must not be before start"
+	case "zero_length":
+		return "must be after start"
+	case "max_span":
+		return "range must not span more than " + fe.Param()
+	case "schedule":
+		return "set exactly one of range or recurring"
+	case "rrule":
+		return fe.Param()
+	case "mixed":
+		return "set exactly one of range or dates"
+	case "dimension":
+		return "set exactly one of range or values"
+	case "zone":
+		return "must be an IANA time zone such as America/New_York"
+	case "oneof":
+		return "must be one of " + fe.Param()
+	}
+	return "failed the " + fe.Tag() + " check"
+}
@@ -0,0 +1,195 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/config"
+	"github.com/keshu12345/overlap-avalara/pkg/response"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidation_FieldErrors(t *testing.T) {
+	strictConfig := &config.Configuration{
+		Validation: config.Validation{
+			RejectZeroLength: true,
+			MaxSpan:          24 * time.Hour,
+			MinYear:          2000,
+			MaxYear:          2100,
+		},
+	}
+	valid := `{"start": "2025-07-01T09:00:00Z", "end": "2025-07-01T10:00:00Z"}`
+
+	testCases := []struct {
+		name        string
+		cfg         *config.Configuration
+		path        string
+		requestBody string
+		expected    map[string]string
+	}{
+		{
+			name:        "Inverted Range",
+			path:        "/api/v1/overlap-check",
+			requestBody: `{"range1": {"start": "2025-07-01T10:00:00Z", "end": "2025-07-01T09:00:00Z"}, "range2": ` + valid + `}`,
+			expected:    map[string]string{"range1.end": "must not be before start"},
+		},
+		{
+			name:        "Zero Length Range When Disallowed",
+			cfg:         strictConfig,
+			path:        "/api/v1/overlap-check",
+			requestBody: `{"range1": ` + valid + `, "range2": {"start": "2025-07-01T09:00:00Z", "end": "2025-07-01T09:00:00Z"}}`,
+			expected:    map[string]string{"range2.end": "must be after start"},
+		},
+		{
+			name:        "Range Longer Than Maximum Span",
+			cfg:         strictConfig,
+			path:        "/api/v2/overlap-check",
+			requestBody: `{"range1": {"start": "2025-07-01T00:00:00Z", "end": "2025-07-03T00:00:00Z"}, "range2": ` + valid + `}`,
+			expected:    map[string]string{"range1.end": "range must not span more than 24h0m0s"},
+		},
+		{
+			name:        "Timestamps Outside Supported Era",
+			path:        "/api/v1/overlap-relation",
+			requestBody: `{"range1": {"start": "1800-01-01T00:00:00Z", "end": "2300-01-01T00:00:00Z"}, "range2": ` + valid + `}`,
+			expected: map[string]string{
+				"range1.start": "must fall within the supported years 1900-2199",
+				"range1.end":   "must fall within the supported years 1900-2199",
+			},
+		},
+		{
+			name:        "Missing Range",
+			path:        "/api/v1/overlap-check",
+			requestBody: `{"range1": ` + valid + `}`,
+			expected:    map[string]string{"range2": "range needs a start or an end"},
+		},
+		{
+			name:        "Missing Window",
+			path:        "/api/v1/free-gaps",
+			requestBody: `{"busy": []}`,
+			expected:    map[string]string{"window": "is required"},
+		},
+		{
+			name:        "Nested Range In A List",
+			path:        "/api/v1/overlap-pairs",
+			requestBody: `{"ranges": [{"range": ` + valid + `}, {"id": "b", "range": {"start": "2025-07-02T00:00:00Z", "end": "2025-07-01T00:00:00Z"}}]}`,
+			expected:    map[string]string{"ranges[1].range.end": "must not be before start"},
+		},
+		{
+			name:        "Option Fields Keep Their JSON Names",
+			path:        "/api/v1/free-gaps",
+			requestBody: `{"window": ` + valid + `, "limit": -1}`,
+			expected:    map[string]string{"limit": "must be at least 0"},
+		},
+		{
+			name:        "Busy Range Of A Participant",
+			path:        "/api/v1/free-slots",
+			requestBody: `{"busy": {"alice": [{"start": "2025-07-01T10:00:00Z", "end": "2025-07-01T09:00:00Z"}]}, "duration": "30m", "window": ` + valid + `}`,
+			expected:    map[string]string{"busy[alice][0].end": "must not be before start"},
+		},
+		{
+			name:        "Negative Request Padding",
+			path:        "/api/v1/overlap-check",
+			requestBody: `{"range1": ` + valid + `, "range2": ` + valid + `, "padding": {"before": "-15m"}}`,
+			expected:    map[string]string{"padding.before": "must be at least 0"},
+		},
+		{
+			name:        "Negative Range Padding",
+			path:        "/api/v1/overlap-check",
+			requestBody: `{"range1": {"start": "2025-07-01T09:00:00Z", "end": "2025-07-01T10:00:00Z", "padding": {"after": "-1h"}}, "range2": ` + valid + `}`,
+			expected:    map[string]string{"range1.padding.after": "must be at least 0"},
+		},
+		{
+			name:        "Minimum Overlap Out Of Range",
+			path:        "/api/v2/overlap-check",
+			requestBody: `{"range1": ` + valid + `, "range2": ` + valid + `, "min_overlap": {"percent": 150, "of": "neither"}}`,
+			expected: map[string]string{
+				"min_overlap.percent": "must be at most 100",
+				"min_overlap.of":      "must be one of either both range1 range2",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			router, mockService, mockLogger := setupTestRouterWithConfig(tc.cfg)
+
+			mockLogger.On("Errorf", "Unable to bind with json body :%v", mock.Anything).Return()
+
+			req, _ := http.NewRequest("POST", tc.path, strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+
+			var errorResponse response.ErrorResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &errorResponse))
+			assert.Equal(t, "Request validation failed", errorResponse.Error.Message)
+			assert.Equal(t, tc.expected, errorResponse.Error.Errors)
+
+			mockService.AssertNotCalled(t, "Check", mock.Anything, mock.Anything, mock.Anything)
+			mockLogger.AssertExpectations(t)
+		})
+	}
+}
+
+func TestValidation_AllowsZeroLengthByDefault(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	r1 := createDateRange("2025-07-01T09:00:00Z", "2025-07-01T09:00:00Z")
+	r2 := createDateRange("2025-07-01T08:00:00Z", "2025-07-01T10:00:00Z")
+	mockService.On("Check", r1, r2, mock.Anything).Return(true)
+	mockLogger.On("Infof", "isOverlap the time range %v", []interface{}{true}).Return()
+
+	body := `{"range1": {"start": "2025-07-01T09:00:00Z", "end": "2025-07-01T09:00:00Z"},
+		"range2": {"start": "2025-07-01T08:00:00Z", "end": "2025-07-01T10:00:00Z"}}`
+	req, _ := http.NewRequest("POST", "/api/v1/overlap-check", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestValidation_RulesBelongToTheRouter(t *testing.T) {
+	strict, _, _ := setupTestRouterWithConfig(&config.Configuration{
+		Validation: config.Validation{RejectZeroLength: true},
+	})
+	// The service and logger are shared by every router, so the ones
+	// registered last serve both.
+	lenient, mockService, mockLogger := setupTestRouter()
+
+	body := `{"range1": {"start": "2025-07-01T09:00:00Z", "end": "2025-07-01T09:00:00Z"},
+		"range2": {"start": "2025-07-01T08:00:00Z", "end": "2025-07-01T10:00:00Z"}}`
+	mockLogger.On("Errorf", "Unable to bind with json body :%v", mock.Anything).Return()
+	mockService.On("Check", mock.Anything, mock.Anything, mock.Anything).Return(true)
+	mockLogger.On("Infof", "isOverlap the time range %v", []interface{}{true}).Return()
+
+	for _, tc := range []struct {
+		router   http.Handler
+		expected int
+	}{
+		{strict, http.StatusBadRequest},
+		{lenient, http.StatusOK},
+	} {
+		req, _ := http.NewRequest("POST", "/api/v1/overlap-check", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		tc.router.ServeHTTP(w, req)
+
+		assert.Equal(t, tc.expected, w.Code)
+	}
+	mockService.AssertNumberOfCalls(t, "Check", 1)
+	mockLogger.AssertExpectations(t)
+}
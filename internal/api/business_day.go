@@ -0,0 +1,30 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/pkg/response"
+)
+
+// CheckBusinessOverlap intersects two ranges on the working days of a holiday calendar.
+func CheckBusinessOverlap(c *gin.Context) {
+	var req data.BusinessOverlapRequest
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	result, err := overlapService.BusinessOverlap(req.Range1, req.Range2, req.BusinessDayOptions)
+	if err != nil {
+		serviceError(c, err)
+		return
+	}
+	appLogger.Infof("found %d overlapping business days", result.BusinessDays)
+	response.NewSuccess(c, result)
+}
+
+// ListCalendars returns the names of the holiday calendars.
+func ListCalendars(c *gin.Context) {
+	result := overlapService.Calendars()
+	appLogger.Infof("found %d holiday calendars", len(result.Calendars))
+	response.NewSuccess(c, result)
+}
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/internal/overlap"
+	"github.com/keshu12345/overlap-avalara/pkg/response"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckBusinessOverlap_ReturnsResult(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	july := data.CivilDateRange{Start: data.NewCivilDate(2025, time.July, 1), End: data.NewCivilDate(2025, time.July, 31)}
+	busy := createDateRange("2025-07-03T13:00:00Z", "2025-07-07T21:00:00Z")
+	r1 := data.MixedRange{Dates: &july}
+	r2 := data.MixedRange{Range: &busy}
+	opts := data.BusinessDayOptions{Calendar: "US"}
+	result := data.BusinessOverlapResult{
+		Overlap: true,
+		Intersections: data.RangeSet{
+			createDateRange("2025-07-03T13:00:00Z", "2025-07-04T04:00:00Z"),
+			createDateRange("2025-07-07T04:00:00Z", "2025-07-07T21:00:00Z"),
+		},
+		BusinessDays: 2,
+		Duration:     data.Duration(32 * time.Hour),
+		Calendar:     "US",
+		Boundary:     data.BoundaryClosedOpen,
+	}
+
+	mockService.On("BusinessOverlap", r1, r2, opts).Return(result, nil)
+	mockLogger.On("Infof", "found %d overlapping business days", []interface{}{2}).Return()
+
+	body := `{"range1": {"dates": {"start": "2025-07-01", "end": "2025-07-31"}},
+		"range2": {"range": {"start": "2025-07-03T13:00:00Z", "end": "2025-07-07T21:00:00Z"}},
+		"calendar": "US"}`
+	req, _ := http.NewRequest("POST", "/api/v1/business-overlap-check", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		Data data.BusinessOverlapResult `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, result, response.Data)
+
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestCheckBusinessOverlap_Errors(t *testing.T) {
+	july := `{"dates": {"start": "2025-07-01", "end": "2025-07-31"}}`
+	testCases := []struct {
+		name        string
+		requestBody string
+		serviceErr  error
+		expected    map[string]string
+	}{
+		{
+			name:        "Missing Calendar",
+			requestBody: `{"range1": ` + july + `, "range2": ` + july + `}`,
+			expected:    map[string]string{"calendar": "is required"},
+		},
+		{
+			name:        "Unknown Calendar",
+			requestBody: `{"range1": ` + july + `, "range2": ` + july + `, "calendar": "XX"}`,
+			serviceErr:  &overlap.FieldError{Field: "calendar", Message: "unknown calendar XX"},
+			expected:    map[string]string{"calendar": "unknown calendar XX"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			router, mockService, mockLogger := setupTestRouter()
+			if tc.serviceErr != nil {
+				mockService.On("BusinessOverlap", mock.Anything, mock.Anything, mock.Anything).Return(data.BusinessOverlapResult{}, tc.serviceErr)
+				mockLogger.On("Errorf", "Unable to complete the request :%v", mock.Anything).Return()
+			} else {
+				mockLogger.On("Errorf", "Unable to bind with json body :%v", mock.Anything).Return()
+			}
+
+			req, _ := http.NewRequest("POST", "/api/v1/business-overlap-check", strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+
+			var errorResponse response.ErrorResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &errorResponse))
+			assert.Equal(t, tc.expected, errorResponse.Error.Errors)
+
+			mockService.AssertExpectations(t)
+			mockLogger.AssertExpectations(t)
+		})
+	}
+}
+
+func TestListCalendars(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	mockService.On("Calendars").Return(data.CalendarList{Calendars: []string{"GB", "US"}})
+	mockLogger.On("Infof", "found %d holiday calendars", []interface{}{2}).Return()
+
+	req, _ := http.NewRequest("GET", "/api/v1/calendars", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"calendars":["GB","US"]`)
+
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
@@ -0,0 +1,36 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/pkg/response"
+)
+
+// CheckDateOverlap intersects two civil date ranges, such as tax periods.
+func CheckDateOverlap(c *gin.Context) {
+	var req data.CivilOverlapRequest
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	result := overlapService.IntersectDates(req.Range1, req.Range2)
+	appLogger.Infof("date overlap result for the date range %+v", result)
+	response.NewSuccess(c, result)
+}
+
+// CheckMixedOverlap intersects two ranges that may each be a timestamp range
+// or a civil date range, reading dates in the zone given by the request.
+func CheckMixedOverlap(c *gin.Context) {
+	var req data.MixedOverlapRequest
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	result, err := overlapService.IntersectMixed(req.Range1, req.Range2, req.MixedOptions)
+	if err != nil {
+		serviceError(c, err)
+		return
+	}
+	appLogger.Infof("mixed overlap result for the time range %+v", result)
+	response.NewSuccess(c, result)
+}
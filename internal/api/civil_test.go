@@ -0,0 +1,138 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/config"
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/pkg/response"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckDateOverlap_ReturnsResult(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	q1 := data.CivilDateRange{Start: data.NewCivilDate(2025, time.January, 1), End: data.NewCivilDate(2025, time.March, 31)}
+	march := data.CivilDateRange{Start: data.NewCivilDate(2025, time.March, 1), End: data.NewCivilDate(2025, time.March, 31)}
+	days, q1Days := 31, 90
+	result := data.CivilOverlapResult{Overlap: true, Intersection: &march, Days: &days, Range1Days: &q1Days, Range2Days: &days}
+
+	mockService.On("IntersectDates", q1, march).Return(result)
+	mockLogger.On("Infof", "date overlap result for the date range %+v", mock.Anything).Return()
+
+	body := `{"range1": {"start": "2025-01-01", "end": "2025-03-31"}, "range2": {"start": "2025-03-01", "end": "2025-03-31"}}`
+	req, _ := http.NewRequest("POST", "/api/v1/date-overlap-check", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"intersection":{"start":"2025-03-01","end":"2025-03-31"}`)
+	assert.Contains(t, w.Body.String(), `"days":31`)
+
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestCheckMixedOverlap_ReturnsResult(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	january := data.CivilDateRange{Start: data.NewCivilDate(2025, time.January, 1), End: data.NewCivilDate(2025, time.January, 31)}
+	instant := createDateRange("2025-02-01T03:00:00Z", "2025-02-01T04:00:00Z")
+	r1 := data.MixedRange{Dates: &january}
+	r2 := data.MixedRange{Range: &instant}
+	opts := data.MixedOptions{Zone: "America/New_York"}
+
+	mockService.On("IntersectMixed", r1, r2, opts).Return(data.ZonedOverlapResult{OutputZone: "America/New_York"}, nil)
+	mockLogger.On("Infof", "mixed overlap result for the time range %+v", mock.Anything).Return()
+
+	body := `{"range1": {"dates": {"start": "2025-01-01", "end": "2025-01-31"}},
+		"range2": {"range": {"start": "2025-02-01T03:00:00Z", "end": "2025-02-01T04:00:00Z"}},
+		"zone": "America/New_York"}`
+	req, _ := http.NewRequest("POST", "/api/v1/mixed-overlap-check", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestDateOverlap_InvalidRequests(t *testing.T) {
+	quarter := `{"start": "2025-01-01", "end": "2025-03-31"}`
+	testCases := []struct {
+		name        string
+		path        string
+		cfg         *config.Configuration
+		requestBody string
+		expected    map[string]string
+	}{
+		{
+			name:        "Inverted Dates",
+			path:        "/api/v1/date-overlap-check",
+			requestBody: `{"range1": {"start": "2025-03-31", "end": "2025-01-01"}, "range2": ` + quarter + `}`,
+			expected:    map[string]string{"range1.end": "must not be before start"},
+		},
+		{
+			name:        "Single Day Is Not Zero Length",
+			path:        "/api/v1/date-overlap-check",
+			cfg:         &config.Configuration{Validation: config.Validation{RejectZeroLength: true, MaxSpan: 24 * time.Hour}},
+			requestBody: `{"range1": {"start": "2025-01-01", "end": "2025-01-01"}, "range2": ` + quarter + `}`,
+			expected:    map[string]string{"range2.end": "range must not span more than 24h0m0s"},
+		},
+		{
+			name:        "Zero Date As A Bound",
+			path:        "/api/v1/date-overlap-check",
+			requestBody: `{"range1": {"start": "0001-01-01", "end": "2025-01-01"}, "range2": ` + quarter + `}`,
+		},
+		{
+			name:        "Mixed Without Zone",
+			path:        "/api/v1/mixed-overlap-check",
+			requestBody: `{"range1": {"dates": ` + quarter + `}, "range2": {"dates": ` + quarter + `}}`,
+			expected:    map[string]string{"zone": "is required"},
+		},
+		{
+			name:        "Mixed With Unknown Zone",
+			path:        "/api/v1/mixed-overlap-check",
+			requestBody: `{"range1": {"dates": ` + quarter + `}, "range2": {"dates": ` + quarter + `}, "zone": "Nowhere"}`,
+			expected:    map[string]string{"zone": "must be an IANA time zone such as America/New_York"},
+		},
+		{
+			name:        "Mixed With Both Kinds In One Range",
+			path:        "/api/v1/mixed-overlap-check",
+			requestBody: `{"range1": {"dates": ` + quarter + `, "range": {"start": "2025-01-01T00:00:00Z", "end": null}}, "range2": {"dates": ` + quarter + `}, "zone": "UTC"}`,
+			expected:    map[string]string{"range1": "set exactly one of range or dates"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			router, mockService, mockLogger := setupTestRouterWithConfig(tc.cfg)
+			mockLogger.On("Errorf", "Unable to bind with json body :%v", mock.Anything).Return()
+
+			req, _ := http.NewRequest("POST", tc.path, strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+
+			var errorResponse response.ErrorResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &errorResponse))
+			assert.Equal(t, tc.expected, errorResponse.Error.Errors)
+			mockService.AssertNotCalled(t, "IntersectDates", mock.Anything, mock.Anything)
+			mockLogger.AssertExpectations(t)
+		})
+	}
+}
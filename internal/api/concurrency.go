@@ -0,0 +1,20 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/pkg/response"
+)
+
+// FindConcurrency returns the highest number of ranges active at once and,
+// when asked for, the occupancy timeline of the whole list.
+func FindConcurrency(c *gin.Context) {
+	var req data.ConcurrencyRequest
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	result := overlapService.Concurrency(req.Ranges, req.ConcurrencyOptions)
+	appLogger.Infof("peak concurrency of %d in %d ranges", result.Peak, len(req.Ranges))
+	response.NewSuccess(c, result)
+}
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindConcurrency_ReturnsTimeline(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	request := data.ConcurrencyRequest{
+		Ranges: []data.IdentifiedRange{
+			{ID: "a", Range: createDateRange("2025-07-01T10:00:00Z", "2025-07-01T12:00:00Z")},
+			{ID: "b", Range: createDateRange("2025-07-01T11:00:00Z", "2025-07-01T13:00:00Z")},
+		},
+		ConcurrencyOptions: data.ConcurrencyOptions{Timeline: true},
+	}
+	peak := data.OccupancySegment{
+		Range:   createDateRange("2025-07-01T11:00:00Z", "2025-07-01T12:00:00Z"),
+		Count:   2,
+		Indexes: []int{0, 1},
+		IDs:     []string{"a", "b"},
+	}
+	result := data.ConcurrencyResult{
+		Peak:         2,
+		PeakSegments: []data.OccupancySegment{peak},
+		Timeline: []data.OccupancySegment{
+			{Range: createDateRange("2025-07-01T10:00:00Z", "2025-07-01T11:00:00Z"), Count: 1, Indexes: []int{0}, IDs: []string{"a"}},
+			peak,
+			{Range: createDateRange("2025-07-01T12:00:00Z", "2025-07-01T13:00:00Z"), Count: 1, Indexes: []int{1}, IDs: []string{"b"}},
+		},
+		Boundary: data.BoundaryClosedOpen,
+	}
+
+	mockService.On("Concurrency", request.Ranges, request.ConcurrencyOptions).Return(result)
+	mockLogger.On("Infof", "peak concurrency of %d in %d ranges", []interface{}{2, 2}).Return()
+
+	requestBody, _ := json.Marshal(request)
+	assert.Contains(t, string(requestBody), `"timeline":true`)
+
+	req, _ := http.NewRequest("POST", "/api/v1/concurrency", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		Data data.ConcurrencyResult `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, result, response.Data)
+
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestFindConcurrency_InvalidRequests(t *testing.T) {
+	testCases := []struct {
+		name        string
+		requestBody string
+	}{
+		{name: "Missing Ranges", requestBody: `{}`},
+		{name: "Empty Ranges", requestBody: `{"ranges": [], "timeline": true}`},
+		{name: "Timeline Not A Bool", requestBody: `{"ranges": [{"range": {"start": "2025-07-01T10:00:00Z", "end": null}}], "timeline": "yes"}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			router, mockService, mockLogger := setupTestRouter()
+			mockLogger.On("Errorf", "Unable to bind with json body :%v", mock.Anything).Return()
+
+			req, _ := http.NewRequest("POST", "/api/v1/concurrency", strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			mockLogger.AssertExpectations(t)
+			mockService.AssertNotCalled(t, "Concurrency")
+		})
+	}
+}
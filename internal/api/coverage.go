@@ -0,0 +1,20 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/pkg/response"
+)
+
+// CheckCoverage reports whether a list of ranges covers a target window, with
+// the gaps and the parts covered more than once.
+func CheckCoverage(c *gin.Context) {
+	var req data.CoverageRequest
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	result := overlapService.Coverage(*req.Target, req.Ranges, req.OverlapOptions)
+	appLogger.Infof("coverage complete: %v, %d gaps, %d duplicated segments", result.Complete, len(result.Gaps), len(result.Duplicated))
+	response.NewSuccess(c, result)
+}
@@ -0,0 +1,87 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckCoverage_ReturnsGapsAndDuplicates(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	target := createDateRange("2025-01-01T00:00:00Z", "2026-01-01T00:00:00Z")
+	request := data.CoverageRequest{
+		Target: &target,
+		Ranges: []data.IdentifiedRange{
+			{ID: "row-1", Range: createDateRange("2025-01-01T00:00:00Z", "2025-07-01T00:00:00Z")},
+			{ID: "row-2", Range: createDateRange("2025-06-01T00:00:00Z", "2025-10-01T00:00:00Z")},
+		},
+	}
+	result := data.CoverageResult{
+		Gaps: data.RangeSet{createDateRange("2025-10-01T00:00:00Z", "2026-01-01T00:00:00Z")},
+		Duplicated: []data.OccupancySegment{{
+			Range:   createDateRange("2025-06-01T00:00:00Z", "2025-07-01T00:00:00Z"),
+			Count:   2,
+			Indexes: []int{0, 1},
+			IDs:     []string{"row-1", "row-2"},
+		}},
+		Boundary: data.BoundaryClosedOpen,
+	}
+
+	mockService.On("Coverage", *request.Target, request.Ranges, request.OverlapOptions).Return(result)
+	mockLogger.On("Infof", "coverage complete: %v, %d gaps, %d duplicated segments", []interface{}{false, 1, 1}).Return()
+
+	requestBody, _ := json.Marshal(request)
+	req, _ := http.NewRequest("POST", "/api/v1/coverage-check", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		Data data.CoverageResult `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, result, response.Data)
+
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestCheckCoverage_InvalidRequests(t *testing.T) {
+	testCases := []struct {
+		name        string
+		requestBody string
+	}{
+		{name: "Missing Target", requestBody: `{"ranges": []}`},
+		{name: "Inverted Row", requestBody: `{"target": {"start": "2025-01-01T00:00:00Z", "end": "2026-01-01T00:00:00Z"},
+			"ranges": [{"range": {"start": "2025-06-01T00:00:00Z", "end": "2025-01-01T00:00:00Z"}}]}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			router, mockService, mockLogger := setupTestRouter()
+			mockLogger.On("Errorf", "Unable to bind with json body :%v", mock.Anything).Return()
+
+			req, _ := http.NewRequest("POST", "/api/v1/coverage-check", strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			mockLogger.AssertExpectations(t)
+			mockService.AssertNotCalled(t, "Coverage")
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/pkg/response"
+)
+
+// FindDimensionalOverlaps returns the pairs of records that conflict in time
+// and in every extra dimension, with the region they share.
+func FindDimensionalOverlaps(c *gin.Context) {
+	var req data.DimensionalOverlapRequest
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	result, err := overlapService.FindDimensionalOverlaps(req.Records, req.OverlapOptions)
+	if err != nil {
+		serviceError(c, err)
+		return
+	}
+	appLogger.Infof("found %d conflicts in %d records", len(result.Conflicts), len(req.Records))
+	response.NewSuccess(c, result)
+}
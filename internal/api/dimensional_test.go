@@ -0,0 +1,138 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/internal/overlap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindDimensionalOverlaps_ReturnsConflicts(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	request := data.DimensionalOverlapRequest{
+		Records: []data.DimensionalRecord{
+			{ID: "wa-food", Range: createDateRange("2025-01-01T00:00:00Z", "2026-01-01T00:00:00Z"), Dimensions: map[string]data.Dimension{
+				"jurisdiction": {Values: []string{"WA"}},
+				"category":     {Values: []string{"food", "beverage"}},
+			}},
+			{ID: "beverage", Range: createDateRange("2025-07-01T00:00:00Z", "2026-07-01T00:00:00Z"), Dimensions: map[string]data.Dimension{
+				"category": {Values: []string{"beverage"}},
+			}},
+		},
+	}
+	result := data.DimensionalOverlapResult{
+		Conflicts: []data.DimensionalConflict{{
+			FirstIndex: 0, FirstID: "wa-food", SecondIndex: 1, SecondID: "beverage",
+			Intersection: createDateRange("2025-07-01T00:00:00Z", "2026-01-01T00:00:00Z"),
+			Dimensions: map[string]data.Dimension{
+				"jurisdiction": {Values: []string{"WA"}},
+				"category":     {Values: []string{"beverage"}},
+			},
+		}},
+		Boundary: data.BoundaryClosedOpen,
+	}
+
+	mockService.On("FindDimensionalOverlaps", request.Records, request.OverlapOptions).Return(result, nil)
+	mockLogger.On("Infof", "found %d conflicts in %d records", []interface{}{1, 2}).Return()
+
+	requestBody, _ := json.Marshal(request)
+	req, _ := http.NewRequest("POST", "/api/v1/dimensional-overlap-pairs", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		Data data.DimensionalOverlapResult `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, result, response.Data)
+
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestFindDimensionalOverlaps_InvalidRequests(t *testing.T) {
+	record := `"range": {"start": "2025-01-01T00:00:00Z", "end": "2026-01-01T00:00:00Z"}`
+	testCases := []struct {
+		name           string
+		requestBody    string
+		expectedErrors map[string]string
+	}{
+		{
+			name:           "Missing Records",
+			requestBody:    `{}`,
+			expectedErrors: map[string]string{"records": "is required"},
+		},
+		{
+			name:           "Dimension Without Range Or Values",
+			requestBody:    `{"records": [{` + record + `, "dimensions": {"category": {}}}]}`,
+			expectedErrors: map[string]string{"records[0].dimensions[category]": "set exactly one of range or values"},
+		},
+		{
+			name:           "Empty Values",
+			requestBody:    `{"records": [{` + record + `, "dimensions": {"category": {"values": []}}}]}`,
+			expectedErrors: map[string]string{"records[0].dimensions[category].values": "must be at least 1"},
+		},
+		{
+			name:           "Inverted Range Dimension",
+			requestBody:    `{"records": [{` + record + `, "dimensions": {"weight": {"range": {"start": 10, "end": 5}}}}]}`,
+			expectedErrors: map[string]string{"records[0].dimensions[weight].range.end": "must not be before start"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			router, mockService, mockLogger := setupTestRouter()
+			mockLogger.On("Errorf", "Unable to bind with json body :%v", mock.Anything).Return()
+
+			req, _ := http.NewRequest("POST", "/api/v1/dimensional-overlap-pairs", strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			for field, message := range tc.expectedErrors {
+				assert.Contains(t, w.Body.String(), `"`+field+`":"`+message+`"`)
+			}
+			mockLogger.AssertExpectations(t)
+			mockService.AssertNotCalled(t, "FindDimensionalOverlaps")
+		})
+	}
+}
+
+func TestFindDimensionalOverlaps_ServiceError(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	mockService.On("FindDimensionalOverlaps", mock.Anything, mock.Anything).Return(data.DimensionalOverlapResult{}, &overlap.FieldError{
+		Field:   "records[1].dimensions[zip]",
+		Message: "must be the same kind of dimension as in records[0], a range or a set of values",
+	})
+	mockLogger.On("Errorf", "Unable to complete the request :%v", mock.Anything).Return()
+
+	body := `{"records": [
+		{"range": {"start": "2025-01-01T00:00:00Z", "end": null}, "dimensions": {"zip": {"values": ["98101"]}}},
+		{"range": {"start": "2025-01-01T00:00:00Z", "end": null}, "dimensions": {"zip": {"range": {"start": 98000, "end": 98199}}}}]}`
+	req, _ := http.NewRequest("POST", "/api/v1/dimensional-overlap-pairs", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), `"records[1].dimensions[zip]":"must be the same kind of dimension`)
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
@@ -0,0 +1,24 @@
+package api
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/keshu12345/overlap-avalara/internal/overlap"
+	"github.com/keshu12345/overlap-avalara/pkg/customerror"
+	httperror "github.com/keshu12345/overlap-avalara/pkg/error"
+)
+
+// serviceError writes the response for an error returned by the overlap
+// service. A FieldError is reported against its field like a validation error.
+func serviceError(c *gin.Context, err error) {
+	cusErr := customerror.NewCustomError(httperror.BadRequest, err.Error())
+	var fieldErr *overlap.FieldError
+	if errors.As(err, &fieldErr) {
+		cusErr = customerror.RequestInvalidError("Request validation failed", customerror.WithErrors(map[string]string{
+			fieldErr.Field: fieldErr.Message,
+		}))
+	}
+	appLogger.Errorf("Unable to complete the request :%v", cusErr)
+	httperror.NewErrorResponse(c, cusErr)
+}
@@ -0,0 +1,24 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/pkg/response"
+)
+
+// FindFilingPeriods returns the filing periods a range touches, with the days
+// of each that fall in the range.
+func FindFilingPeriods(c *gin.Context) {
+	var req data.FilingPeriodRequest
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	result, err := overlapService.FilingPeriods(req.Range, req.FilingOptions)
+	if err != nil {
+		serviceError(c, err)
+		return
+	}
+	appLogger.Infof("range touches %d %s filing periods", len(result.Periods), result.Frequency)
+	response.NewSuccess(c, result)
+}
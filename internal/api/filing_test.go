@@ -0,0 +1,145 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/internal/overlap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindFilingPeriods_ReturnsPeriods(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	request := data.FilingPeriodRequest{
+		Range: createDateRange("2025-06-01T00:00:00Z", "2025-08-01T00:00:00Z"),
+		FilingOptions: data.FilingOptions{
+			Frequency: data.FilingQuarterly, FiscalYearStart: 7,
+			OverlapOptions: data.OverlapOptions{Boundary: data.BoundaryClosedOpen},
+		},
+	}
+	result := data.FilingPeriodResult{
+		Periods: []data.FilingPeriod{
+			{
+				Label:        "FY2025-Q4",
+				FiscalYear:   2025,
+				Period:       createDateRange("2025-04-01T00:00:00Z", "2025-07-01T00:00:00Z"),
+				Intersection: createDateRange("2025-06-01T00:00:00Z", "2025-07-01T00:00:00Z"),
+				Duration:     data.Duration(30 * 24 * time.Hour),
+				Days:         30,
+				PeriodDays:   91,
+			},
+			{
+				Label:        "FY2026-Q1",
+				FiscalYear:   2026,
+				Period:       createDateRange("2025-07-01T00:00:00Z", "2025-10-01T00:00:00Z"),
+				Intersection: createDateRange("2025-07-01T00:00:00Z", "2025-08-01T00:00:00Z"),
+				Duration:     data.Duration(31 * 24 * time.Hour),
+				Days:         31,
+				PeriodDays:   92,
+			},
+		},
+		Days:      61,
+		Frequency: data.FilingQuarterly,
+		Boundary:  data.BoundaryClosedOpen,
+	}
+
+	mockService.On("FilingPeriods", request.Range, request.FilingOptions).Return(result, nil)
+	mockLogger.On("Infof", "range touches %d %s filing periods", []interface{}{2, data.FilingQuarterly}).Return()
+
+	requestBody, _ := json.Marshal(request)
+	req, _ := http.NewRequest("POST", "/api/v1/filing-periods", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		Data data.FilingPeriodResult `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, result, response.Data)
+
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestFindFilingPeriods_InvalidRequests(t *testing.T) {
+	r := `"range": {"start": "2025-01-01T00:00:00Z", "end": "2025-04-01T00:00:00Z"}`
+	testCases := []struct {
+		name           string
+		requestBody    string
+		expectedErrors map[string]string
+	}{
+		{
+			name:           "Missing Frequency",
+			requestBody:    `{` + r + `}`,
+			expectedErrors: map[string]string{"frequency": "is required"},
+		},
+		{
+			name:           "Unknown Frequency",
+			requestBody:    `{` + r + `, "frequency": "weekly"}`,
+			expectedErrors: map[string]string{"frequency": "must be one of monthly quarterly semi_annual annual"},
+		},
+		{
+			name:           "Fiscal Year Start Out Of Range",
+			requestBody:    `{` + r + `, "frequency": "annual", "fiscal_year_start": 13}`,
+			expectedErrors: map[string]string{"fiscal_year_start": "must be at most 12"},
+		},
+		{
+			name:           "Unknown Zone",
+			requestBody:    `{` + r + `, "frequency": "annual", "zone": "Mars/Olympus_Mons"}`,
+			expectedErrors: map[string]string{"zone": "must be an IANA time zone such as America/New_York"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			router, mockService, mockLogger := setupTestRouter()
+			mockLogger.On("Errorf", "Unable to bind with json body :%v", mock.Anything).Return()
+
+			req, _ := http.NewRequest("POST", "/api/v1/filing-periods", strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			for field, message := range tc.expectedErrors {
+				assert.Contains(t, w.Body.String(), `"`+field+`":"`+message+`"`)
+			}
+			mockLogger.AssertExpectations(t)
+			mockService.AssertNotCalled(t, "FilingPeriods")
+		})
+	}
+}
+
+func TestFindFilingPeriods_ServiceError(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	mockService.On("FilingPeriods", mock.Anything, mock.Anything).
+		Return(data.FilingPeriodResult{}, &overlap.FieldError{Field: "range", Message: "filing periods need a range with a start and an end"})
+	mockLogger.On("Errorf", "Unable to complete the request :%v", mock.Anything).Return()
+
+	body := `{"range": {"start": "2025-01-01T00:00:00Z", "end": null}, "frequency": "monthly"}`
+	req, _ := http.NewRequest("POST", "/api/v1/filing-periods", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), `"range":"filing periods need a range with a start and an end"`)
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
@@ -0,0 +1,19 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/pkg/response"
+)
+
+// FindGaps returns the free gaps left in a window by a list of busy ranges.
+func FindGaps(c *gin.Context) {
+	var req data.GapRequest
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	result := overlapService.FindGaps(*req.Window, req.Busy, req.GapOptions)
+	appLogger.Infof("found %d free gaps", len(result.Gaps))
+	response.NewSuccess(c, result)
+}
@@ -0,0 +1,113 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindGaps_ReturnsGaps(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	window := createDateRange("2025-07-01T08:00:00Z", "2025-07-01T18:00:00Z")
+	request := data.GapRequest{
+		Window: &window,
+		Busy:   []data.DateRange{createDateRange("2025-07-01T09:00:00Z", "2025-07-01T17:00:00Z")},
+		GapOptions: data.GapOptions{
+			MinGap: data.Duration(30 * time.Minute),
+			Limit:  5,
+		},
+	}
+	result := data.GapResult{
+		Gaps: data.RangeSet{
+			createDateRange("2025-07-01T08:00:00Z", "2025-07-01T09:00:00Z"),
+			createDateRange("2025-07-01T17:00:00Z", "2025-07-01T18:00:00Z"),
+		},
+		Boundary: data.BoundaryClosedOpen,
+	}
+
+	mockService.On("FindGaps", *request.Window, request.Busy, request.GapOptions).Return(result)
+	mockLogger.On("Infof", "found %d free gaps", []interface{}{2}).Return()
+
+	requestBody, _ := json.Marshal(request)
+	assert.Contains(t, string(requestBody), `"min_gap":"30m0s"`)
+
+	req, _ := http.NewRequest("POST", "/api/v1/free-gaps", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		Data data.GapResult `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, result, response.Data)
+
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestFindGaps_AcceptsWindowWithoutBounds(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	busy := []data.DateRange{createDateRange("2025-07-01T09:00:00Z", "2025-07-01T17:00:00Z")}
+	result := data.GapResult{
+		Gaps:     data.RangeSet{{End: busy[0].Start}, {Start: busy[0].End}},
+		Boundary: data.BoundaryClosedOpen,
+	}
+	mockService.On("FindGaps", data.DateRange{}, busy, data.GapOptions{}).Return(result)
+	mockLogger.On("Infof", "found %d free gaps", []interface{}{2}).Return()
+
+	body := `{"window": {"start": null, "end": null}, "busy": [{"start": "2025-07-01T09:00:00Z", "end": "2025-07-01T17:00:00Z"}]}`
+	req, _ := http.NewRequest("POST", "/api/v1/free-gaps", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestFindGaps_InvalidRequests(t *testing.T) {
+	window := `"window": {"start": "2025-07-01T08:00:00Z", "end": "2025-07-01T18:00:00Z"}`
+	testCases := []struct {
+		name        string
+		requestBody string
+	}{
+		{name: "Missing Window", requestBody: `{"busy": []}`},
+		{name: "Unparsable Minimum Gap", requestBody: `{` + window + `, "min_gap": "soon"}`},
+		{name: "Negative Minimum Gap", requestBody: `{` + window + `, "min_gap": "-5m"}`},
+		{name: "Negative Limit", requestBody: `{` + window + `, "limit": -1}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			router, mockService, mockLogger := setupTestRouter()
+			mockLogger.On("Errorf", "Unable to bind with json body :%v", mock.Anything).Return()
+
+			req, _ := http.NewRequest("POST", "/api/v1/free-gaps", strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			mockLogger.AssertExpectations(t)
+			mockService.AssertNotCalled(t, "FindGaps")
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/keshu12345/overlap-avalara/data"
+)
+
+// joinFlushRows is how many rows are written between flushes of a streamed join.
+const joinFlushRows = 500
+
+// JoinRanges streams the interval join of two range sets as newline-delimited
+// JSON, one row per line, so a large join reaches the client while it is
+// still being computed. The last line carries the summary, so a client can
+// tell a complete stream from one cut short. Request problems are reported
+// before the stream starts, in the usual error response.
+func JoinRanges(c *gin.Context) {
+	var req data.JoinRequest
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	c.Header("Content-Type", "application/x-ndjson")
+	c.Status(http.StatusOK)
+	encoder := json.NewEncoder(c.Writer)
+	rows := 0
+	summary, err := overlapService.Join(req.Left, req.Right, req.JoinOptions, func(row data.JoinRow) error {
+		if err := encoder.Encode(row); err != nil {
+			return err
+		}
+		if rows++; rows%joinFlushRows == 0 {
+			c.Writer.Flush()
+		}
+		return nil
+	})
+	if err != nil {
+		appLogger.Errorf("interval join stopped after %d rows :%v", rows, err)
+		return
+	}
+	if err := encoder.Encode(data.JoinTrailer{Summary: summary}); err != nil {
+		appLogger.Errorf("interval join lost its summary after %d rows :%v", rows, err)
+		return
+	}
+	c.Writer.Flush()
+	appLogger.Infof("interval join streamed %d matched and %d unmatched rows", summary.Matched, summary.Unmatched)
+}
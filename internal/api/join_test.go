@@ -0,0 +1,138 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJoinRanges_StreamsRows(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	request := data.JoinRequest{
+		Left: []data.IdentifiedRange{
+			{ID: "txn-1", Range: createDateRange("2025-01-15T00:00:00Z", "2025-02-15T00:00:00Z")},
+			{ID: "txn-2", Range: createDateRange("2024-06-01T00:00:00Z", "2024-07-01T00:00:00Z")},
+		},
+		Right: []data.IdentifiedRange{
+			{ID: "rate-2025", Range: createDateRange("2025-01-01T00:00:00Z", "2026-01-01T00:00:00Z")},
+		},
+		JoinOptions: data.JoinOptions{Mode: data.JoinLeftOuter},
+	}
+	right := 0
+	intersection := createDateRange("2025-01-15T00:00:00Z", "2025-02-15T00:00:00Z")
+	rows := []data.JoinRow{
+		{LeftIndex: 0, LeftID: "txn-1", RightIndex: &right, RightID: "rate-2025", Intersection: &intersection, Duration: intersection.Duration()},
+		{LeftIndex: 1, LeftID: "txn-2"},
+	}
+
+	mockService.On("Join", request.Left, request.Right, request.JoinOptions, mock.Anything).
+		Run(func(args mock.Arguments) {
+			emit := args.Get(3).(func(data.JoinRow) error)
+			for _, row := range rows {
+				require.NoError(t, emit(row))
+			}
+		}).
+		Return(data.JoinSummary{Matched: 1, Unmatched: 1, Boundary: data.BoundaryClosedOpen}, nil)
+	mockLogger.On("Infof", "interval join streamed %d matched and %d unmatched rows", []interface{}{1, 1}).Return()
+
+	requestBody, _ := json.Marshal(request)
+	req, _ := http.NewRequest("POST", "/api/v1/interval-join", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/x-ndjson", w.Header().Get("Content-Type"))
+	assert.True(t, w.Flushed)
+
+	body := w.Body.String()
+	var lines [][]byte
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	for scanner.Scan() {
+		lines = append(lines, append([]byte(nil), scanner.Bytes()...))
+	}
+	require.Len(t, lines, len(rows)+1)
+
+	var streamed []data.JoinRow
+	for _, line := range lines[:len(rows)] {
+		var row data.JoinRow
+		require.NoError(t, json.Unmarshal(line, &row))
+		streamed = append(streamed, row)
+	}
+	assert.Equal(t, rows, streamed)
+	assert.JSONEq(t, `{"summary":{"matched":1,"unmatched":1,"boundary":"[)"}}`, string(lines[len(rows)]))
+	assert.Contains(t, body, `{"left_index":1,"left_id":"txn-2","right_index":null,"intersection":null,"duration":"0s"}`)
+
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestJoinRanges_StoppedStreamHasNoSummary(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	request := data.JoinRequest{
+		Left:  []data.IdentifiedRange{{ID: "txn-1", Range: createDateRange("2025-01-15T00:00:00Z", "2025-02-15T00:00:00Z")}},
+		Right: []data.IdentifiedRange{{ID: "rate-2025", Range: createDateRange("2025-01-01T00:00:00Z", "2026-01-01T00:00:00Z")}},
+	}
+	stopped := errors.New("client went away")
+	mockService.On("Join", request.Left, request.Right, request.JoinOptions, mock.Anything).
+		Run(func(args mock.Arguments) {
+			emit := args.Get(3).(func(data.JoinRow) error)
+			require.NoError(t, emit(data.JoinRow{LeftIndex: 0, LeftID: "txn-1"}))
+		}).
+		Return(data.JoinSummary{}, stopped)
+	mockLogger.On("Errorf", "interval join stopped after %d rows :%v", []interface{}{1, stopped}).Return()
+
+	requestBody, _ := json.Marshal(request)
+	req, _ := http.NewRequest("POST", "/api/v1/interval-join", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), `"summary"`)
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestJoinRanges_InvalidRequests(t *testing.T) {
+	testCases := []struct {
+		name        string
+		requestBody string
+	}{
+		{name: "Missing Right", requestBody: `{"left": [{"range": {"start": "2025-01-01T00:00:00Z", "end": null}}]}`},
+		{name: "Unknown Mode", requestBody: `{"left": [], "right": [], "mode": "full_outer"}`},
+		{name: "Inverted Left Range", requestBody: `{"left": [{"range": {"start": "2025-02-01T00:00:00Z", "end": "2025-01-01T00:00:00Z"}}], "right": []}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			router, mockService, mockLogger := setupTestRouter()
+			mockLogger.On("Errorf", "Unable to bind with json body :%v", mock.Anything).Return()
+
+			req, _ := http.NewRequest("POST", "/api/v1/interval-join", strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Contains(t, w.Header().Get("Content-Type"), "application/json")
+			mockLogger.AssertExpectations(t)
+			mockService.AssertNotCalled(t, "Join")
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/pkg/response"
+)
+
+// FindOverlaps returns every overlapping pair in a list of ranges.
+func FindOverlaps(c *gin.Context) {
+	var req data.MultiOverlapRequest
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	result := overlapService.FindOverlaps(req.Ranges, req.OverlapOptions)
+	appLogger.Infof("found %d overlapping pairs in %d ranges", len(result.Pairs), len(req.Ranges))
+	response.NewSuccess(c, result)
+}
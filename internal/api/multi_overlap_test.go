@@ -0,0 +1,88 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindOverlaps_ReturnsPairs(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	request := data.MultiOverlapRequest{
+		Ranges: []data.IdentifiedRange{
+			{ID: "a", Range: createDateRange("2025-07-01T10:00:00Z", "2025-07-01T12:00:00Z")},
+			{ID: "b", Range: createDateRange("2025-07-01T11:00:00Z", "2025-07-01T13:00:00Z")},
+			{Range: createDateRange("2025-07-01T14:00:00Z", "2025-07-01T15:00:00Z")},
+		},
+	}
+	result := data.MultiOverlapResult{
+		Pairs: []data.OverlapPair{{
+			FirstIndex:   0,
+			FirstID:      "a",
+			SecondIndex:  1,
+			SecondID:     "b",
+			Intersection: createDateRange("2025-07-01T11:00:00Z", "2025-07-01T12:00:00Z"),
+			Duration:     data.Duration(time.Hour),
+		}},
+		Boundary: data.BoundaryClosedOpen,
+	}
+
+	mockService.On("FindOverlaps", request.Ranges, request.OverlapOptions).Return(result)
+	mockLogger.On("Infof", "found %d overlapping pairs in %d ranges", []interface{}{1, 3}).Return()
+
+	requestBody, _ := json.Marshal(request)
+	req, _ := http.NewRequest("POST", "/api/v1/overlap-pairs", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		Data data.MultiOverlapResult `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, result, response.Data)
+
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestFindOverlaps_InvalidRequests(t *testing.T) {
+	testCases := []struct {
+		name        string
+		requestBody string
+	}{
+		{name: "Missing Ranges", requestBody: `{}`},
+		{name: "Empty Ranges", requestBody: `{"ranges": []}`},
+		{name: "Range Missing End", requestBody: `{"ranges": [{"id": "a", "range": {"start": "2025-07-01T10:00:00Z"}}]}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			router, mockService, mockLogger := setupTestRouter()
+			mockLogger.On("Errorf", "Unable to bind with json body :%v", mock.Anything).Return()
+
+			req, _ := http.NewRequest("POST", "/api/v1/overlap-pairs", strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			mockLogger.AssertExpectations(t)
+			mockService.AssertNotCalled(t, "FindOverlaps")
+		})
+	}
+}
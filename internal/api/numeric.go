@@ -0,0 +1,56 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/pkg/response"
+)
+
+// CheckIntOverlap returns the intersection and relation of two integer ranges.
+func CheckIntOverlap(c *gin.Context) {
+	var req data.NumericOverlapRequest[int64]
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	result := overlapService.IntersectInts(req.Range1, req.Range2, req.Boundary)
+	appLogger.Infof("integer range overlap %v, relation %v", result.Overlap, result.Relation)
+	response.NewSuccess(c, result)
+}
+
+// FindIntOverlaps returns every overlapping pair in a list of integer ranges.
+func FindIntOverlaps(c *gin.Context) {
+	var req data.NumericPairsRequest[int64]
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	result := overlapService.FindIntOverlaps(req.Ranges, req.Boundary)
+	appLogger.Infof("found %d overlapping pairs in %d integer ranges", len(result.Pairs), len(req.Ranges))
+	response.NewSuccess(c, result)
+}
+
+// CheckDecimalOverlap returns the intersection and relation of two decimal
+// ranges. Bounds are compared exactly, whatever their precision.
+func CheckDecimalOverlap(c *gin.Context) {
+	var req data.NumericOverlapRequest[data.Decimal]
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	result := overlapService.IntersectDecimals(req.Range1, req.Range2, req.Boundary)
+	appLogger.Infof("decimal range overlap %v, relation %v", result.Overlap, result.Relation)
+	response.NewSuccess(c, result)
+}
+
+// FindDecimalOverlaps returns every overlapping pair in a list of decimal ranges.
+func FindDecimalOverlaps(c *gin.Context) {
+	var req data.NumericPairsRequest[data.Decimal]
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	result := overlapService.FindDecimalOverlaps(req.Ranges, req.Boundary)
+	appLogger.Infof("found %d overlapping pairs in %d decimal ranges", len(result.Pairs), len(req.Ranges))
+	response.NewSuccess(c, result)
+}
@@ -0,0 +1,170 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func intRange(start, end int64) data.IntRange {
+	return data.IntRange{Start: &start, End: &end}
+}
+
+func decimal(s string) data.Decimal {
+	d, err := data.ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func decimalRange(start, end string) data.DecimalRange {
+	s, e := decimal(start), decimal(end)
+	return data.DecimalRange{Start: &s, End: &e}
+}
+
+func TestCheckIntOverlap(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	intersection := intRange(10400, 10500)
+	length := int64(100)
+	result := data.NumericOverlapResult[int64]{
+		Overlap:      true,
+		Intersection: &intersection,
+		Length:       &length,
+		Relation:     data.RelationOverlaps,
+		Boundary:     data.BoundaryClosed,
+	}
+	mockService.On("IntersectInts", intRange(10000, 10500), data.IntRange{Start: intersection.Start}, data.BoundaryClosed).Return(result)
+	mockLogger.On("Infof", "integer range overlap %v, relation %v", []interface{}{true, data.RelationOverlaps}).Return()
+
+	body := `{"range1": {"start": 10000, "end": 10500}, "range2": {"start": 10400, "end": null}, "boundary": "[]"}`
+	req, _ := http.NewRequest("POST", "/api/v1/int-range/overlap-check", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"intersection":{"start":10400,"end":10500},"length":100`)
+
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestFindDecimalOverlaps(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	result := data.NumericPairsResult[data.Decimal]{
+		Pairs: []data.NumericPair[data.Decimal]{{
+			FirstIndex:   0,
+			FirstID:      "reduced",
+			SecondIndex:  1,
+			SecondID:     "standard",
+			Intersection: decimalRange("0.0725", "1/3"),
+		}},
+		Boundary: data.BoundaryClosedOpen,
+	}
+	matchesRequest := mock.MatchedBy(func(ranges []data.IdentifiedNumericRange[data.Decimal]) bool {
+		return len(ranges) == 2 &&
+			ranges[0].Range.Start.String() == "0" &&
+			ranges[0].Range.End.String() == "1/3" &&
+			ranges[1].Range.Start.String() == "0.0725" &&
+			ranges[1].Range.End == nil
+	})
+	mockService.On("FindDecimalOverlaps", matchesRequest, data.Boundary("")).Return(result)
+	mockLogger.On("Infof", "found %d overlapping pairs in %d decimal ranges", []interface{}{1, 2}).Return()
+
+	body := `{"ranges": [
+		{"id": "reduced", "range": {"start": 0, "end": "1/3"}},
+		{"id": "standard", "range": {"start": "0.07250", "end": null}}]}`
+	req, _ := http.NewRequest("POST", "/api/v1/decimal-range/overlap-pairs", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"intersection":{"start":"0.0725","end":"1/3"}`)
+
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestNumericRanges_InvalidRequests(t *testing.T) {
+	testCases := []struct {
+		name           string
+		path           string
+		requestBody    string
+		expectedErrors map[string]string
+	}{
+		{
+			name:           "Inverted Integer Range",
+			path:           "/api/v1/int-range/overlap-check",
+			requestBody:    `{"range1": {"start": 10, "end": 5}, "range2": {"start": 1, "end": 2}}`,
+			expectedErrors: map[string]string{"range1.end": "must not be before start"},
+		},
+		{
+			name:           "Unbounded Integer Range",
+			path:           "/api/v1/int-range/overlap-pairs",
+			requestBody:    `{"ranges": [{"range": {"start": null, "end": null}}]}`,
+			expectedErrors: map[string]string{"ranges[0].range.start": "range needs a start or an end"},
+		},
+		{
+			name:           "Inverted Decimal Range",
+			path:           "/api/v1/decimal-range/overlap-check",
+			requestBody:    `{"range1": {"start": "0.5", "end": "0.49999"}, "range2": {"start": 1, "end": 2}}`,
+			expectedErrors: map[string]string{"range1.end": "must not be before start"},
+		},
+		{
+			name:        "Not A Decimal",
+			path:        "/api/v1/decimal-range/overlap-pairs",
+			requestBody: `{"ranges": [{"range": {"start": "seven", "end": null}}]}`,
+		},
+		{
+			name:        "Decimal Exponent Too Large",
+			path:        "/api/v1/decimal-range/overlap-pairs",
+			requestBody: `{"ranges": [{"range": {"start": "1e-80000", "end": null}}]}`,
+		},
+		{
+			name:        "Too Many Decimal Digits",
+			path:        "/api/v1/decimal-range/overlap-pairs",
+			requestBody: `{"ranges": [{"range": {"start": "0.` + strings.Repeat("1", 1000) + `", "end": null}}]}`,
+		},
+		{
+			name:        "Fractional Integer",
+			path:        "/api/v1/int-range/overlap-check",
+			requestBody: `{"range1": {"start": 1.5, "end": 5}, "range2": {"start": 1, "end": 2}}`,
+		},
+		{
+			name:        "Missing End Key",
+			path:        "/api/v1/int-range/overlap-check",
+			requestBody: `{"range1": {"start": 1}, "range2": {"start": 1, "end": 2}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			router, mockService, mockLogger := setupTestRouter()
+			mockLogger.On("Errorf", "Unable to bind with json body :%v", mock.Anything).Return()
+
+			req, _ := http.NewRequest("POST", tc.path, strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			for field, message := range tc.expectedErrors {
+				assert.Contains(t, w.Body.String(), `"`+field+`":"`+message+`"`)
+			}
+			mockLogger.AssertExpectations(t)
+			assert.Empty(t, mockService.Calls)
+		})
+	}
+}
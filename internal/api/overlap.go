@@ -10,14 +10,51 @@ import (
 
 func CheckOverlap(c *gin.Context) {
 	var req data.OverlapRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		cusErr := customerror.NewCustomError(error.BadRequest, err.Error())
-		appLogger.Errorf("Unable to bind with json body :%v", cusErr)
-		error.NewErrorResponse(c, cusErr)
+	if !bindRequest(c, &req) {
 		return
 	}
 
-	isOverlap := overlapService.Check(req.Range1, req.Range2)
+	isOverlap := overlapService.Check(req.Range1, req.Range2, req.OverlapOptions)
 	appLogger.Infof("isOverlap the time range %v", isOverlap)
 	response.NewSuccess(c, isOverlap)
 }
+
+// CheckOverlapV2 returns the full intersection of the two ranges instead of a bare bool.
+func CheckOverlapV2(c *gin.Context) {
+	var req data.OverlapRequest
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	result := overlapService.Intersect(req.Range1, req.Range2, req.OverlapOptions)
+	appLogger.Infof("overlap result for the time range %+v", result)
+	response.NewSuccess(c, result)
+}
+
+// ClassifyOverlap returns the Allen interval relation of range1 to range2.
+func ClassifyOverlap(c *gin.Context) {
+	var req data.OverlapRequest
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	relation := overlapService.Relate(req.Range1, req.Range2, req.OverlapOptions)
+	appLogger.Infof("relation of the time range %v", relation)
+	response.NewSuccess(c, data.RelationResult{Relation: relation})
+}
+
+// bindRequest binds and validates the JSON body into req. On failure it writes
+// the error response and returns false, so handlers can simply return.
+// Validation problems are reported per field in the response errors.
+func bindRequest(c *gin.Context, req interface{}) bool {
+	if err := c.MustGet(binderKey).(*requestBinder).bind(c, req); err != nil {
+		cusErr := customerror.NewCustomError(error.BadRequest, err.Error())
+		if fields := fieldErrors(req, err); fields != nil {
+			cusErr = customerror.RequestInvalidError("Request validation failed", customerror.WithErrors(fields))
+		}
+		appLogger.Errorf("Unable to bind with json body :%v", cusErr)
+		error.NewErrorResponse(c, cusErr)
+		return false
+	}
+	return true
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/keshu12345/overlap-avalara/config"
 	"github.com/keshu12345/overlap-avalara/data"
 
 	"github.com/stretchr/testify/assert"
@@ -67,19 +68,168 @@ type MockOverlapService struct {
 	mock.Mock
 }
 
-func (m *MockOverlapService) Check(r1, r2 data.DateRange) bool {
-	args := m.Called(r1, r2)
+func (m *MockOverlapService) Check(r1, r2 data.DateRange, opts data.OverlapOptions) bool {
+	args := m.Called(r1, r2, opts)
 	return args.Bool(0)
 }
 
+func (m *MockOverlapService) Intersect(r1, r2 data.DateRange, opts data.OverlapOptions) data.OverlapResult {
+	args := m.Called(r1, r2, opts)
+	return args.Get(0).(data.OverlapResult)
+}
+
+func (m *MockOverlapService) Relate(r1, r2 data.DateRange, opts data.OverlapOptions) data.Relation {
+	args := m.Called(r1, r2, opts)
+	return args.Get(0).(data.Relation)
+}
+
+func (m *MockOverlapService) FindOverlaps(ranges []data.IdentifiedRange, opts data.OverlapOptions) data.MultiOverlapResult {
+	args := m.Called(ranges, opts)
+	return args.Get(0).(data.MultiOverlapResult)
+}
+
+func (m *MockOverlapService) FindDimensionalOverlaps(records []data.DimensionalRecord, opts data.OverlapOptions) (data.DimensionalOverlapResult, error) {
+	args := m.Called(records, opts)
+	return args.Get(0).(data.DimensionalOverlapResult), args.Error(1)
+}
+
+func (m *MockOverlapService) Join(left, right []data.IdentifiedRange, opts data.JoinOptions, emit func(data.JoinRow) error) (data.JoinSummary, error) {
+	args := m.Called(left, right, opts, emit)
+	return args.Get(0).(data.JoinSummary), args.Error(1)
+}
+
+func (m *MockOverlapService) Concurrency(ranges []data.IdentifiedRange, opts data.ConcurrencyOptions) data.ConcurrencyResult {
+	args := m.Called(ranges, opts)
+	return args.Get(0).(data.ConcurrencyResult)
+}
+
+func (m *MockOverlapService) Union(a, b []data.DateRange, opts data.OverlapOptions) data.RangeSetResult {
+	args := m.Called(a, b, opts)
+	return args.Get(0).(data.RangeSetResult)
+}
+
+func (m *MockOverlapService) IntersectSets(a, b []data.DateRange, opts data.OverlapOptions) data.RangeSetResult {
+	args := m.Called(a, b, opts)
+	return args.Get(0).(data.RangeSetResult)
+}
+
+func (m *MockOverlapService) Difference(a, b []data.DateRange, opts data.OverlapOptions) data.RangeSetResult {
+	args := m.Called(a, b, opts)
+	return args.Get(0).(data.RangeSetResult)
+}
+
+func (m *MockOverlapService) SymmetricDifference(a, b []data.DateRange, opts data.OverlapOptions) data.RangeSetResult {
+	args := m.Called(a, b, opts)
+	return args.Get(0).(data.RangeSetResult)
+}
+
+func (m *MockOverlapService) Complement(set []data.DateRange, window data.DateRange, opts data.OverlapOptions) data.RangeSetResult {
+	args := m.Called(set, window, opts)
+	return args.Get(0).(data.RangeSetResult)
+}
+
+func (m *MockOverlapService) FindGaps(window data.DateRange, busy []data.DateRange, opts data.GapOptions) data.GapResult {
+	args := m.Called(window, busy, opts)
+	return args.Get(0).(data.GapResult)
+}
+
+func (m *MockOverlapService) FindSlots(busy map[string][]data.DateRange, duration data.Duration, window data.DateRange, opts data.SlotOptions) (data.SlotResult, error) {
+	args := m.Called(busy, duration, window, opts)
+	return args.Get(0).(data.SlotResult), args.Error(1)
+}
+
+func (m *MockOverlapService) ResolveConflicts(ranges []data.ResolvableRange, opts data.ResolveOptions) data.ResolutionResult {
+	args := m.Called(ranges, opts)
+	return args.Get(0).(data.ResolutionResult)
+}
+
+func (m *MockOverlapService) Coverage(target data.DateRange, ranges []data.IdentifiedRange, opts data.OverlapOptions) data.CoverageResult {
+	args := m.Called(target, ranges, opts)
+	return args.Get(0).(data.CoverageResult)
+}
+
+func (m *MockOverlapService) ValidateTaxRates(rows []data.TaxRateRow, window *data.DateRange, opts data.OverlapOptions) data.TaxRateReport {
+	args := m.Called(rows, window, opts)
+	return args.Get(0).(data.TaxRateReport)
+}
+
+func (m *MockOverlapService) Prorate(period data.DateRange, rates []data.RatePeriod, opts data.ProrationOptions) (data.ProrationResult, error) {
+	args := m.Called(period, rates, opts)
+	return args.Get(0).(data.ProrationResult), args.Error(1)
+}
+
+func (m *MockOverlapService) FilingPeriods(r data.DateRange, opts data.FilingOptions) (data.FilingPeriodResult, error) {
+	args := m.Called(r, opts)
+	return args.Get(0).(data.FilingPeriodResult), args.Error(1)
+}
+
+func (m *MockOverlapService) Partition(records []data.PartitionRecord, opts data.PartitionOptions) data.PartitionResult {
+	args := m.Called(records, opts)
+	return args.Get(0).(data.PartitionResult)
+}
+
+func (m *MockOverlapService) FindRecurringOverlaps(s1, s2 data.Schedule, opts data.RecurrenceOptions) (data.RecurringOverlapResult, error) {
+	args := m.Called(s1, s2, opts)
+	return args.Get(0).(data.RecurringOverlapResult), args.Error(1)
+}
+
+func (m *MockOverlapService) IntersectZoned(r1, r2 data.ZonedRange, opts data.ZoneOptions) (data.ZonedOverlapResult, error) {
+	args := m.Called(r1, r2, opts)
+	return args.Get(0).(data.ZonedOverlapResult), args.Error(1)
+}
+
+func (m *MockOverlapService) IntersectDates(r1, r2 data.CivilDateRange) data.CivilOverlapResult {
+	args := m.Called(r1, r2)
+	return args.Get(0).(data.CivilOverlapResult)
+}
+
+func (m *MockOverlapService) BusinessOverlap(r1, r2 data.MixedRange, opts data.BusinessDayOptions) (data.BusinessOverlapResult, error) {
+	args := m.Called(r1, r2, opts)
+	return args.Get(0).(data.BusinessOverlapResult), args.Error(1)
+}
+
+func (m *MockOverlapService) Calendars() data.CalendarList {
+	args := m.Called()
+	return args.Get(0).(data.CalendarList)
+}
+
+func (m *MockOverlapService) IntersectInts(r1, r2 data.IntRange, b data.Boundary) data.NumericOverlapResult[int64] {
+	args := m.Called(r1, r2, b)
+	return args.Get(0).(data.NumericOverlapResult[int64])
+}
+
+func (m *MockOverlapService) IntersectDecimals(r1, r2 data.DecimalRange, b data.Boundary) data.NumericOverlapResult[data.Decimal] {
+	args := m.Called(r1, r2, b)
+	return args.Get(0).(data.NumericOverlapResult[data.Decimal])
+}
+
+func (m *MockOverlapService) FindIntOverlaps(ranges []data.IdentifiedNumericRange[int64], b data.Boundary) data.NumericPairsResult[int64] {
+	args := m.Called(ranges, b)
+	return args.Get(0).(data.NumericPairsResult[int64])
+}
+
+func (m *MockOverlapService) FindDecimalOverlaps(ranges []data.IdentifiedNumericRange[data.Decimal], b data.Boundary) data.NumericPairsResult[data.Decimal] {
+	args := m.Called(ranges, b)
+	return args.Get(0).(data.NumericPairsResult[data.Decimal])
+}
+
+func (m *MockOverlapService) IntersectMixed(r1, r2 data.MixedRange, opts data.MixedOptions) (data.ZonedOverlapResult, error) {
+	args := m.Called(r1, r2, opts)
+	return args.Get(0).(data.ZonedOverlapResult), args.Error(1)
+}
+
 func setupTestRouter() (*gin.Engine, *MockOverlapService, *MockLogger) {
+	return setupTestRouterWithConfig(nil)
+}
+
+func setupTestRouterWithConfig(cfg *config.Configuration) (*gin.Engine, *MockOverlapService, *MockLogger) {
 	gin.SetMode(gin.TestMode)
 	router := gin.New()
 
 	mockService := &MockOverlapService{}
 	mockLogger := &MockLogger{}
 
-	RegisterEndpoint(router, mockService, mockLogger)
+	RegisterEndpoint(router, mockService, mockLogger, cfg)
 
 	return router, mockService, mockLogger
 }
@@ -101,7 +251,7 @@ func TestCheckOverlap_ValidOverlappingRanges(t *testing.T) {
 		Range2: createDateRange("2025-07-01T11:00:00Z", "2025-07-01T13:00:00Z"),
 	}
 
-	mockService.On("Check", request.Range1, request.Range2).Return(true)
+	mockService.On("Check", request.Range1, request.Range2, request.OverlapOptions).Return(true)
 	mockLogger.On("Infof", "isOverlap the time range %v", []interface{}{true}).Return()
 
 	requestBody, _ := json.Marshal(request)
@@ -134,7 +284,7 @@ func TestCheckOverlap_ValidNonOverlappingRanges(t *testing.T) {
 	}
 
 	// Set up mock expectations
-	mockService.On("Check", request.Range1, request.Range2).Return(false)
+	mockService.On("Check", request.Range1, request.Range2, request.OverlapOptions).Return(false)
 	mockLogger.On("Infof", "isOverlap the time range %v", []interface{}{false}).Return()
 
 	// Create HTTP request
@@ -238,7 +388,7 @@ func TestCheckOverlap_EdgeCaseDateRanges(t *testing.T) {
 			}
 
 			// Set up mock expectations
-			mockService.On("Check", request.Range1, request.Range2).Return(tc.expectedResult)
+			mockService.On("Check", request.Range1, request.Range2, request.OverlapOptions).Return(tc.expectedResult)
 			mockLogger.On("Infof", "isOverlap the time range %v", []interface{}{tc.expectedResult}).Return()
 
 			// Create HTTP request
@@ -302,7 +452,7 @@ func TestRegisterEndpoint(t *testing.T) {
 
 	// Test that registration doesn't panic
 	require.NotPanics(t, func() {
-		RegisterEndpoint(router, mockService, mockLogger)
+		RegisterEndpoint(router, mockService, mockLogger, nil)
 	})
 
 	// Test that the route exists
@@ -321,7 +471,7 @@ func TestCheckOverlap_ConcurrentRequests(t *testing.T) {
 	router, mockService, mockLogger := setupTestRouter()
 
 	// Set up mock expectations for multiple calls
-	mockService.On("Check", mock.AnythingOfType("data.DateRange"), mock.AnythingOfType("data.DateRange")).Return(true)
+	mockService.On("Check", mock.AnythingOfType("data.DateRange"), mock.AnythingOfType("data.DateRange"), mock.AnythingOfType("data.OverlapOptions")).Return(true)
 	mockLogger.On("Infof", "isOverlap the time range %v", []interface{}{true}).Return()
 
 	// Create test request
@@ -420,7 +570,7 @@ func TestCheckOverlap_ValidRequests(t *testing.T) {
 			mockLogger := &MockLogger{}
 
 			// Register endpoints
-			RegisterEndpoint(router, mockService, mockLogger)
+			RegisterEndpoint(router, mockService, mockLogger, nil)
 
 			// Create test request
 			request := data.OverlapRequest{
@@ -428,7 +578,7 @@ func TestCheckOverlap_ValidRequests(t *testing.T) {
 				Range2: createDateRange(tc.range2Start, tc.range2End),
 			}
 
-			mockService.On("Check", request.Range1, request.Range2).Return(tc.expectedResult)
+			mockService.On("Check", request.Range1, request.Range2, request.OverlapOptions).Return(tc.expectedResult)
 
 			mockLogger.On("Infof", "isOverlap the time range %v", mock.Anything).Return()
 
@@ -495,7 +645,7 @@ func TestCheckOverlap_InvalidJSON(t *testing.T) {
 			mockService := &MockOverlapService{}
 			mockLogger := &MockLogger{}
 
-			RegisterEndpoint(router, mockService, mockLogger)
+			RegisterEndpoint(router, mockService, mockLogger, nil)
 
 			mockLogger.On("Errorf", "Unable to bind with json body :%v", mock.Anything).Return()
 			req, _ := http.NewRequest("POST", "/api/v1/overlap-check", strings.NewReader(tc.requestBody))
@@ -544,8 +694,330 @@ func TestCheckOverlap_MissingFields(t *testing.T) {
 			mockService := &MockOverlapService{}
 			mockLogger := &MockLogger{}
 
-			RegisterEndpoint(router, mockService, mockLogger)
+			RegisterEndpoint(router, mockService, mockLogger, nil)
+
+			mockLogger.On("Errorf", "Unable to bind with json body :%v", mock.Anything).Return()
+
+			req, _ := http.NewRequest("POST", "/api/v1/overlap-check", strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			mockLogger.AssertExpectations(t)
+			mockService.AssertNotCalled(t, "Check")
+		})
+	}
+}
+
+func TestCheckOverlapV2_ReturnsIntersection(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	request := data.OverlapRequest{
+		Range1: createDateRange("2025-07-01T10:00:00Z", "2025-07-01T12:00:00Z"),
+		Range2: createDateRange("2025-07-01T11:00:00Z", "2025-07-01T15:00:00Z"),
+	}
+	intersection := createDateRange("2025-07-01T11:00:00Z", "2025-07-01T12:00:00Z")
+	result := data.OverlapResult{
+		Overlap:        true,
+		Intersection:   &intersection,
+		Duration:       data.Duration(time.Hour),
+		Range1Coverage: 0.5,
+		Range2Coverage: 0.25,
+	}
+
+	mockService.On("Intersect", request.Range1, request.Range2, request.OverlapOptions).Return(result)
+	mockLogger.On("Infof", "overlap result for the time range %+v", mock.Anything).Return()
+
+	requestBody, _ := json.Marshal(request)
+	req, _ := http.NewRequest("POST", "/api/v2/overlap-check", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+
+	assert.Equal(t, true, response.Data["overlap"])
+	assert.Equal(t, "1h0m0s", response.Data["duration"])
+	assert.Equal(t, 0.5, response.Data["range1_coverage"])
+	assert.Equal(t, 0.25, response.Data["range2_coverage"])
+	assert.Equal(t, map[string]interface{}{
+		"start": "2025-07-01T11:00:00Z",
+		"end":   "2025-07-01T12:00:00Z",
+	}, response.Data["intersection"])
+
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestCheckOverlapV2_InvalidJSON(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	mockLogger.On("Errorf", "Unable to bind with json body :%v", mock.Anything).Return()
+
+	req, _ := http.NewRequest("POST", "/api/v2/overlap-check", strings.NewReader(`{"range1": {"start": "2025-07-01T10:00:00Z"}}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockLogger.AssertExpectations(t)
+	mockService.AssertNotCalled(t, "Intersect")
+}
+
+func TestClassifyOverlap_ReturnsRelation(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	request := data.OverlapRequest{
+		Range1: createDateRange("2025-07-01T10:00:00Z", "2025-07-01T12:00:00Z"),
+		Range2: createDateRange("2025-07-01T12:00:00Z", "2025-07-01T13:00:00Z"),
+	}
+
+	mockService.On("Relate", request.Range1, request.Range2, request.OverlapOptions).Return(data.RelationMeets)
+	mockLogger.On("Infof", "relation of the time range %v", []interface{}{data.RelationMeets}).Return()
+
+	requestBody, _ := json.Marshal(request)
+	req, _ := http.NewRequest("POST", "/api/v1/overlap-relation", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, map[string]interface{}{"relation": "meets"}, response["data"])
 
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestCheckOverlapV2_PassesBoundary(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	request := data.OverlapRequest{
+		Range1:         createDateRange("2025-07-01T10:00:00Z", "2025-07-01T11:00:00Z"),
+		Range2:         createDateRange("2025-07-01T11:00:00Z", "2025-07-01T12:00:00Z"),
+		OverlapOptions: data.OverlapOptions{Boundary: data.BoundaryClosed},
+	}
+	intersection := createDateRange("2025-07-01T11:00:00Z", "2025-07-01T11:00:00Z")
+
+	mockService.On("Intersect", request.Range1, request.Range2, data.OverlapOptions{Boundary: data.BoundaryClosed}).
+		Return(data.OverlapResult{Overlap: true, Intersection: &intersection, Range1Coverage: 0, Range2Coverage: 0, Boundary: data.BoundaryClosed})
+	mockLogger.On("Infof", "overlap result for the time range %+v", mock.Anything).Return()
+
+	requestBody, _ := json.Marshal(request)
+	assert.Contains(t, string(requestBody), `"boundary":"[]"`)
+
+	req, _ := http.NewRequest("POST", "/api/v2/overlap-check", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "[]", response.Data["boundary"])
+
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestCheckOverlapV2_PassesPadding(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	range1 := createDateRange("2025-07-01T09:00:00Z", "2025-07-01T10:00:00Z")
+	range1.Padding = data.Padding{After: data.Duration(5 * time.Minute)}
+	range2 := createDateRange("2025-07-01T10:10:00Z", "2025-07-01T11:00:00Z")
+	opts := data.OverlapOptions{Padding: data.Padding{Before: data.Duration(15 * time.Minute)}}
+	conflict := createDateRange("2025-07-01T09:55:00Z", "2025-07-01T10:05:00Z")
+
+	mockService.On("Intersect", range1, range2, opts).
+		Return(data.OverlapResult{Overlap: true, Intersection: &conflict, Duration: data.Duration(10 * time.Minute), Boundary: data.BoundaryClosedOpen})
+	mockLogger.On("Infof", "overlap result for the time range %+v", mock.Anything).Return()
+
+	body := `{"range1": {"start": "2025-07-01T09:00:00Z", "end": "2025-07-01T10:00:00Z", "padding": {"after": "5m"}},
+		"range2": {"start": "2025-07-01T10:10:00Z", "end": "2025-07-01T11:00:00Z"},
+		"padding": {"before": "15m"}}`
+	req, _ := http.NewRequest("POST", "/api/v2/overlap-check", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.NotContains(t, response.Data, "raw_intersection", "a near miss has no raw intersection")
+
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestCheckOverlapV2_PassesMinOverlap(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	range1 := createDateRange("2025-07-01T10:00:00Z", "2025-07-01T12:00:00Z")
+	range2 := createDateRange("2025-07-01T11:00:00Z", "2025-07-01T15:00:00Z")
+	opts := data.OverlapOptions{MinOverlap: &data.MinOverlap{Duration: data.Duration(time.Minute), Percent: 50, Of: data.MinOverlapOfRange1}}
+	intersection := createDateRange("2025-07-01T11:00:00Z", "2025-07-01T12:00:00Z")
+
+	mockService.On("Intersect", range1, range2, opts).
+		Return(data.OverlapResult{Overlap: true, Intersection: &intersection, Duration: data.Duration(time.Hour), Range1Coverage: 0.5, Range2Coverage: 0.25, Jaccard: 0.2, Boundary: data.BoundaryClosedOpen})
+	mockLogger.On("Infof", "overlap result for the time range %+v", mock.Anything).Return()
+
+	body := `{"range1": {"start": "2025-07-01T10:00:00Z", "end": "2025-07-01T12:00:00Z"},
+		"range2": {"start": "2025-07-01T11:00:00Z", "end": "2025-07-01T15:00:00Z"},
+		"min_overlap": {"duration": "1m", "percent": 50, "of": "range1"}}`
+	req, _ := http.NewRequest("POST", "/api/v2/overlap-check", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 0.2, response.Data["jaccard"])
+
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestCheckOverlap_UnknownBoundary(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	mockLogger.On("Errorf", "Unable to bind with json body :%v", mock.Anything).Return()
+
+	body := `{"range1": {"start": "2025-07-01T10:00:00Z", "end": "2025-07-01T12:00:00Z"}, "range2": {"start": "2025-07-01T11:00:00Z", "end": "2025-07-01T13:00:00Z"}, "boundary": "<>"}`
+	for _, path := range []string{"/api/v1/overlap-check", "/api/v2/overlap-check"} {
+		req, _ := http.NewRequest("POST", path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, path)
+	}
+
+	mockLogger.AssertExpectations(t)
+	mockService.AssertNotCalled(t, "Check")
+	mockService.AssertNotCalled(t, "Intersect")
+}
+
+func TestCheckOverlapV2_OpenEndedRanges(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	start, _ := time.Parse(time.RFC3339, "2025-01-01T00:00:00Z")
+	end, _ := time.Parse(time.RFC3339, "2025-06-01T00:00:00Z")
+	range1 := data.DateRange{Start: start}
+	range2 := data.DateRange{End: end}
+	intersection := data.DateRange{Start: start, End: end}
+
+	mockService.On("Intersect", range1, range2, data.OverlapOptions{}).
+		Return(data.OverlapResult{Overlap: true, Intersection: &intersection, Duration: data.Duration(time.Hour), Boundary: data.BoundaryClosedOpen})
+	mockLogger.On("Infof", "overlap result for the time range %+v", mock.Anything).Return()
+
+	body := `{"range1": {"start": "2025-01-01T00:00:00Z", "end": null}, "range2": {"start": null, "end": "2025-06-01T00:00:00Z"}}`
+	req, _ := http.NewRequest("POST", "/api/v2/overlap-check", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestDateRange_JSONRoundTripsMissingBoundAsNull(t *testing.T) {
+	start, _ := time.Parse(time.RFC3339, "2025-01-01T00:00:00Z")
+
+	encoded, err := json.Marshal(data.OverlapResult{
+		Overlap:      true,
+		Intersection: &data.DateRange{Start: start},
+		Duration:     data.InfiniteDuration,
+	})
+	require.NoError(t, err)
+	assert.Contains(t, string(encoded), `"intersection":{"start":"2025-01-01T00:00:00Z","end":null}`)
+	assert.Contains(t, string(encoded), `"duration":null`)
+
+	var decoded data.DateRange
+	require.NoError(t, json.Unmarshal([]byte(`{"start":"2025-01-01T00:00:00Z","end":null}`), &decoded))
+	assert.Equal(t, data.DateRange{Start: start}, decoded)
+
+	var d data.Duration
+	assert.Error(t, json.Unmarshal([]byte(`null`), &d), "an unbounded length is only ever written")
+}
+
+func TestCheckOverlap_RejectsNullDurations(t *testing.T) {
+	testCases := []struct {
+		name        string
+		requestBody string
+	}{
+		{"Null Padding", `{"range1": {"start": "2025-01-01T00:00:00Z", "end": "2025-02-01T00:00:00Z"}, "range2": {"start": "2025-01-15T00:00:00Z", "end": "2025-03-01T00:00:00Z"}, "padding": {"before": null}}`},
+		{"Null Minimum Overlap", `{"range1": {"start": "2025-01-01T00:00:00Z", "end": "2025-02-01T00:00:00Z"}, "range2": {"start": "2025-01-15T00:00:00Z", "end": "2025-03-01T00:00:00Z"}, "min_overlap": {"duration": null}}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			router, mockService, mockLogger := setupTestRouter()
+			mockLogger.On("Errorf", "Unable to bind with json body :%v", mock.Anything).Return()
+
+			req, _ := http.NewRequest("POST", "/api/v1/overlap-check", strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			mockLogger.AssertExpectations(t)
+			assert.Empty(t, mockService.Calls)
+		})
+	}
+}
+
+func TestCheckOverlap_RejectsUnboundedRanges(t *testing.T) {
+	testCases := []struct {
+		name        string
+		requestBody string
+	}{
+		{
+			name:        "Both Bounds Null",
+			requestBody: `{"range1": {"start": null, "end": null}, "range2": {"start": "2025-07-01T11:00:00Z", "end": "2025-07-01T13:00:00Z"}}`,
+		},
+		{
+			name:        "Range Not An Object",
+			requestBody: `{"range1": "always", "range2": {"start": "2025-07-01T11:00:00Z", "end": "2025-07-01T13:00:00Z"}}`,
+		},
+		{
+			name:        "Zero Instant As A Bound",
+			requestBody: `{"range1": {"start": "0001-01-01T00:00:00Z", "end": "2025-07-01T12:00:00Z"}, "range2": {"start": "2025-07-01T11:00:00Z", "end": "2025-07-01T13:00:00Z"}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			router, mockService, mockLogger := setupTestRouter()
 			mockLogger.On("Errorf", "Unable to bind with json body :%v", mock.Anything).Return()
 
 			req, _ := http.NewRequest("POST", "/api/v1/overlap-check", strings.NewReader(tc.requestBody))
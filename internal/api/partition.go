@@ -0,0 +1,20 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/pkg/response"
+)
+
+// PartitionRecords splits overlapping effective-dated records into
+// non-overlapping segments, each listing the records that apply to it.
+func PartitionRecords(c *gin.Context) {
+	var req data.PartitionRequest
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	result := overlapService.Partition(req.Records, req.PartitionOptions)
+	appLogger.Infof("partitioned %d records into %d segments", len(req.Records), len(result.Segments))
+	response.NewSuccess(c, result)
+}
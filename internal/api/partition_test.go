@@ -0,0 +1,79 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/pkg/response"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartitionRecords_ReturnsSegments(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	request := data.PartitionRequest{
+		Records: []data.PartitionRecord{
+			{ID: "base", Range: createDateRange("2025-01-01T00:00:00Z", "2026-01-01T00:00:00Z"), Priority: 1},
+			{ID: "override", Range: createDateRange("2025-04-01T00:00:00Z", "2025-07-01T00:00:00Z"), Priority: 5},
+		},
+		PartitionOptions: data.PartitionOptions{Policy: data.PartitionHighestPriority},
+	}
+	result := data.PartitionResult{
+		Segments: []data.OccupancySegment{
+			{Range: createDateRange("2025-01-01T00:00:00Z", "2025-04-01T00:00:00Z"), Count: 1, Indexes: []int{0}, IDs: []string{"base"}},
+			{Range: createDateRange("2025-04-01T00:00:00Z", "2025-07-01T00:00:00Z"), Count: 1, Indexes: []int{1}, IDs: []string{"override"}},
+			{Range: createDateRange("2025-07-01T00:00:00Z", "2026-01-01T00:00:00Z"), Count: 1, Indexes: []int{0}, IDs: []string{"base"}},
+		},
+		Policy:   data.PartitionHighestPriority,
+		Boundary: data.BoundaryClosedOpen,
+	}
+
+	mockService.On("Partition", request.Records, request.PartitionOptions).Return(result)
+	mockLogger.On("Infof", "partitioned %d records into %d segments", []interface{}{2, 3}).Return()
+
+	requestBody, _ := json.Marshal(request)
+	req, _ := http.NewRequest("POST", "/api/v1/partition", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		Data data.PartitionResult `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, result, response.Data)
+
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestPartitionRecords_UnknownPolicy(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+	mockLogger.On("Errorf", "Unable to bind with json body :%v", mock.Anything).Return()
+
+	body := `{"records": [{"range": {"start": "2025-01-01T00:00:00Z", "end": "2026-01-01T00:00:00Z"}}], "policy": "first_wins"}`
+	req, _ := http.NewRequest("POST", "/api/v1/partition", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var errorResponse response.ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &errorResponse))
+	assert.Equal(t, map[string]string{"policy": "must be one of keep_all latest_start highest_priority"}, errorResponse.Error.Errors)
+
+	mockLogger.AssertExpectations(t)
+	mockService.AssertNotCalled(t, "Partition")
+}
@@ -0,0 +1,24 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/pkg/response"
+)
+
+// ProrateBillingPeriod splits a billing period, and optionally a billed
+// amount, across the rate periods it overlaps.
+func ProrateBillingPeriod(c *gin.Context) {
+	var req data.ProrationRequest
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	result, err := overlapService.Prorate(req.Period, req.Rates, req.ProrationOptions)
+	if err != nil {
+		serviceError(c, err)
+		return
+	}
+	appLogger.Infof("prorated billing period over %d of %d rate periods", len(result.Shares), len(req.Rates))
+	response.NewSuccess(c, result)
+}
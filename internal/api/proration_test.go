@@ -0,0 +1,147 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/internal/overlap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProrateBillingPeriod_ReturnsShares(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	value, amount, places := decimal("31"), decimal("100"), 2
+	request := data.ProrationRequest{
+		Period: createDateRange("2025-01-01T00:00:00Z", "2025-02-01T00:00:00Z"),
+		Rates: []data.RatePeriod{
+			{ID: "new", Range: createDateRange("2025-01-11T00:00:00Z", "2025-03-01T00:00:00Z"), Value: &value},
+		},
+		ProrationOptions: data.ProrationOptions{
+			DayCount: data.DayCountActualDays, Amount: &amount, Places: &places,
+			OverlapOptions: data.OverlapOptions{Boundary: data.BoundaryClosed},
+		},
+	}
+	share := decimal("67.74")
+	result := data.ProrationResult{
+		Shares: []data.ProrationShare{{
+			Index:        0,
+			ID:           "new",
+			Intersection: createDateRange("2025-01-11T00:00:00Z", "2025-02-01T00:00:00Z"),
+			Units:        decimal("21"),
+			Fraction:     decimal("21/31"),
+			Value:        value,
+			Weighted:     decimal("21"),
+			Amount:       &share,
+		}},
+		Units:         decimal("31"),
+		Covered:       decimal("21/31"),
+		WeightedValue: decimal("21"),
+		Amount:        &share,
+		DayCount:      data.DayCountActualDays,
+		Boundary:      data.BoundaryClosed,
+	}
+
+	mockService.On("Prorate", request.Period, request.Rates, request.ProrationOptions).Return(result, nil)
+	mockLogger.On("Infof", "prorated billing period over %d of %d rate periods", []interface{}{1, 1}).Return()
+
+	requestBody, _ := json.Marshal(request)
+	req, _ := http.NewRequest("POST", "/api/v1/proration", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"fraction":"21/31"`)
+	assert.Contains(t, w.Body.String(), `"amount":"67.74"`)
+	assert.Contains(t, w.Body.String(), `"boundary":"[]"`)
+
+	var response struct {
+		Data data.ProrationResult `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, result.Amount.String(), response.Data.Amount.String())
+	assert.Equal(t, result.Shares[0].Fraction.String(), response.Data.Shares[0].Fraction.String())
+
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestProrateBillingPeriod_InvalidRequests(t *testing.T) {
+	period := `"period": {"start": "2025-01-01T00:00:00Z", "end": "2025-02-01T00:00:00Z"}`
+	rates := `"rates": [{"range": {"start": "2025-01-01T00:00:00Z", "end": null}, "value": "10"}]`
+	testCases := []struct {
+		name           string
+		requestBody    string
+		expectedErrors map[string]string
+	}{
+		{
+			name:           "No Rates",
+			requestBody:    `{` + period + `, "rates": []}`,
+			expectedErrors: map[string]string{"rates": "must be at least 1"},
+		},
+		{
+			name:           "Missing Value",
+			requestBody:    `{` + period + `, "rates": [{"range": {"start": "2025-01-01T00:00:00Z", "end": null}}]}`,
+			expectedErrors: map[string]string{"rates[0].value": "is required"},
+		},
+		{
+			name:           "Unknown Day Count",
+			requestBody:    `{` + period + `, ` + rates + `, "day_count": "actual_365"}`,
+			expectedErrors: map[string]string{"day_count": "must be one of actual_seconds actual_days thirty_360"},
+		},
+		{
+			name:           "Negative Places",
+			requestBody:    `{` + period + `, ` + rates + `, "amount": "100", "places": -1}`,
+			expectedErrors: map[string]string{"places": "must be at least 0"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			router, mockService, mockLogger := setupTestRouter()
+			mockLogger.On("Errorf", "Unable to bind with json body :%v", mock.Anything).Return()
+
+			req, _ := http.NewRequest("POST", "/api/v1/proration", strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			for field, message := range tc.expectedErrors {
+				assert.Contains(t, w.Body.String(), `"`+field+`":"`+message+`"`)
+			}
+			mockLogger.AssertExpectations(t)
+			mockService.AssertNotCalled(t, "Prorate")
+		})
+	}
+}
+
+func TestProrateBillingPeriod_ServiceError(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	mockService.On("Prorate", mock.Anything, mock.Anything, mock.Anything).
+		Return(data.ProrationResult{}, &overlap.FieldError{Field: "period", Message: "proration needs a billing period with a start and an end"})
+	mockLogger.On("Errorf", "Unable to complete the request :%v", mock.Anything).Return()
+
+	body := `{"period": {"start": "2025-01-01T00:00:00Z", "end": null}, "rates": [{"range": {"start": "2025-01-01T00:00:00Z", "end": null}, "value": "10"}]}`
+	req, _ := http.NewRequest("POST", "/api/v1/proration", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), `"period":"proration needs a billing period with a start and an end"`)
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/pkg/response"
+)
+
+// rangeSetOperation is the shape shared by the binary range-set operations of the overlap service.
+type rangeSetOperation func(a, b []data.DateRange, opts data.OverlapOptions) data.RangeSetResult
+
+func UnionRanges(c *gin.Context) {
+	handleRangeSetOperation(c, "union", overlapService.Union)
+}
+
+func IntersectRanges(c *gin.Context) {
+	handleRangeSetOperation(c, "intersection", overlapService.IntersectSets)
+}
+
+func SubtractRanges(c *gin.Context) {
+	handleRangeSetOperation(c, "difference", overlapService.Difference)
+}
+
+func SymmetricDifferenceRanges(c *gin.Context) {
+	handleRangeSetOperation(c, "symmetric difference", overlapService.SymmetricDifference)
+}
+
+func ComplementRanges(c *gin.Context) {
+	var req data.ComplementRequest
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	result := overlapService.Complement(req.Set, *req.Window, req.OverlapOptions)
+	appLogger.Infof("range set %s has %d ranges", "complement", len(result.Ranges))
+	response.NewSuccess(c, result)
+}
+
+func handleRangeSetOperation(c *gin.Context, name string, operation rangeSetOperation) {
+	var req data.RangeSetRequest
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	result := operation(req.A, req.B, req.OverlapOptions)
+	appLogger.Infof("range set %s has %d ranges", name, len(result.Ranges))
+	response.NewSuccess(c, result)
+}
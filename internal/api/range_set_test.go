@@ -0,0 +1,126 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRangeSetEndpoints(t *testing.T) {
+	request := data.RangeSetRequest{
+		A: []data.DateRange{createDateRange("2025-07-01T08:00:00Z", "2025-07-01T12:00:00Z")},
+		B: []data.DateRange{createDateRange("2025-07-01T10:00:00Z", "2025-07-01T14:00:00Z")},
+	}
+	result := data.RangeSetResult{
+		Ranges:   data.RangeSet{createDateRange("2025-07-01T08:00:00Z", "2025-07-01T10:00:00Z")},
+		Boundary: data.BoundaryClosedOpen,
+	}
+
+	testCases := []struct {
+		path   string
+		method string
+		name   string
+	}{
+		{path: "/api/v1/range-set/union", method: "Union", name: "union"},
+		{path: "/api/v1/range-set/intersection", method: "IntersectSets", name: "intersection"},
+		{path: "/api/v1/range-set/difference", method: "Difference", name: "difference"},
+		{path: "/api/v1/range-set/symmetric-difference", method: "SymmetricDifference", name: "symmetric difference"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.method, func(t *testing.T) {
+			router, mockService, mockLogger := setupTestRouter()
+
+			mockService.On(tc.method, request.A, request.B, request.OverlapOptions).Return(result)
+			mockLogger.On("Infof", "range set %s has %d ranges", []interface{}{tc.name, 1}).Return()
+
+			requestBody, _ := json.Marshal(request)
+			req, _ := http.NewRequest("POST", tc.path, bytes.NewBuffer(requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			var response struct {
+				Data data.RangeSetResult `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, result, response.Data)
+
+			mockService.AssertExpectations(t)
+			mockLogger.AssertExpectations(t)
+		})
+	}
+}
+
+func TestComplementRanges(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	window := createDateRange("2025-07-01T08:00:00Z", "2025-07-01T18:00:00Z")
+	request := data.ComplementRequest{
+		Set:            []data.DateRange{createDateRange("2025-07-01T10:00:00Z", "2025-07-01T12:00:00Z")},
+		Window:         &window,
+		OverlapOptions: data.OverlapOptions{Boundary: data.BoundaryClosedOpen},
+	}
+	result := data.RangeSetResult{
+		Ranges: data.RangeSet{
+			createDateRange("2025-07-01T08:00:00Z", "2025-07-01T10:00:00Z"),
+			createDateRange("2025-07-01T12:00:00Z", "2025-07-01T18:00:00Z"),
+		},
+		Boundary: data.BoundaryClosedOpen,
+	}
+
+	mockService.On("Complement", request.Set, *request.Window, request.OverlapOptions).Return(result)
+	mockLogger.On("Infof", "range set %s has %d ranges", []interface{}{"complement", 2}).Return()
+
+	requestBody, _ := json.Marshal(request)
+	req, _ := http.NewRequest("POST", "/api/v1/range-set/complement", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestRangeSetEndpoints_InvalidRequests(t *testing.T) {
+	testCases := []struct {
+		name        string
+		path        string
+		requestBody string
+	}{
+		{name: "Union Without A", path: "/api/v1/range-set/union", requestBody: `{"b": []}`},
+		{name: "Difference With Bad Member", path: "/api/v1/range-set/difference", requestBody: `{"a": [{"start": "nope", "end": "2025-07-01T12:00:00Z"}]}`},
+		{name: "Complement Without Window", path: "/api/v1/range-set/complement", requestBody: `{"set": []}`},
+		{name: "Member With Its Own Boundary", path: "/api/v1/range-set/union", requestBody: `{"a": [{"start": "2025-07-01T08:00:00Z", "end": "2025-07-01T12:00:00Z", "boundary": "[]"}]}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			router, mockService, mockLogger := setupTestRouter()
+			mockLogger.On("Errorf", "Unable to bind with json body :%v", mock.Anything).Return()
+
+			req, _ := http.NewRequest("POST", tc.path, strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			mockLogger.AssertExpectations(t)
+			assert.Empty(t, mockService.Calls)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/pkg/response"
+)
+
+// FindRecurringOverlaps returns the first clashing occurrences of two
+// schedules, each a fixed range or a recurring series.
+func FindRecurringOverlaps(c *gin.Context) {
+	var req data.RecurringOverlapRequest
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	result, err := overlapService.FindRecurringOverlaps(req.Schedule1, req.Schedule2, req.RecurrenceOptions)
+	if err != nil {
+		serviceError(c, err)
+		return
+	}
+	appLogger.Infof("found %d clashes between recurring schedules", len(result.Clashes))
+	response.NewSuccess(c, result)
+}
@@ -0,0 +1,148 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/pkg/response"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func parseTime(s string) time.Time {
+	t, _ := time.Parse(time.RFC3339, s)
+	return t
+}
+
+func TestFindRecurringOverlaps_ReturnsClashes(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	fixedRange := createDateRange("2025-07-14T10:00:00Z", "2025-07-14T12:00:00Z")
+	request := data.RecurringOverlapRequest{
+		Schedule1: data.Schedule{Recurring: &data.RecurringRange{
+			DTStart:  parseTime("2025-07-07T09:00:00Z"),
+			Duration: data.Duration(2 * time.Hour),
+			RRule:    "FREQ=WEEKLY;BYDAY=MO",
+			ExDates:  []time.Time{parseTime("2025-07-21T09:00:00Z")},
+		}},
+		Schedule2:         data.Schedule{Range: &fixedRange},
+		RecurrenceOptions: data.RecurrenceOptions{Limit: 5},
+	}
+	result := data.RecurringOverlapResult{
+		Overlap: true,
+		Clashes: []data.OccurrenceClash{{
+			First:        createDateRange("2025-07-14T09:00:00Z", "2025-07-14T11:00:00Z"),
+			Second:       fixedRange,
+			Intersection: createDateRange("2025-07-14T10:00:00Z", "2025-07-14T11:00:00Z"),
+			Duration:     data.Duration(time.Hour),
+		}},
+		Boundary: data.BoundaryClosedOpen,
+	}
+
+	mockService.On("FindRecurringOverlaps", request.Schedule1, request.Schedule2, request.RecurrenceOptions).Return(result, nil)
+	mockLogger.On("Infof", "found %d clashes between recurring schedules", []interface{}{1}).Return()
+
+	requestBody, _ := json.Marshal(request)
+	req, _ := http.NewRequest("POST", "/api/v1/recurring-overlap", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		Data data.RecurringOverlapResult `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, result, response.Data)
+
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestFindRecurringOverlaps_ServiceError(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	mockService.On("FindRecurringOverlaps", mock.Anything, mock.Anything, mock.Anything).
+		Return(data.RecurringOverlapResult{}, errors.New("expansion failed"))
+	mockLogger.On("Errorf", "Unable to complete the request :%v", mock.Anything).Return()
+
+	body := `{"schedule1": {"range": {"start": "2025-07-01T00:00:00Z", "end": "2025-07-02T00:00:00Z"}},
+		"schedule2": {"recurring": {"dtstart": "2025-07-01T09:00:00Z", "duration": "1h", "rrule": "FREQ=DAILY"}}}`
+	req, _ := http.NewRequest("POST", "/api/v1/recurring-overlap", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestFindRecurringOverlaps_InvalidRequests(t *testing.T) {
+	fixedRange := `{"range": {"start": "2025-07-01T00:00:00Z", "end": "2025-07-02T00:00:00Z"}}`
+	testCases := []struct {
+		name        string
+		requestBody string
+		expected    map[string]string
+	}{
+		{
+			name:        "Missing Schedule",
+			requestBody: `{"schedule1": ` + fixedRange + `}`,
+			expected:    map[string]string{"schedule2": "set exactly one of range or recurring"},
+		},
+		{
+			name: "Both Range And Recurring",
+			requestBody: `{"schedule1": ` + fixedRange + `, "schedule2": {"range": {"start": "2025-07-01T00:00:00Z", "end": null},
+				"recurring": {"dtstart": "2025-07-01T09:00:00Z", "duration": "1h"}}}`,
+			expected: map[string]string{"schedule2": "set exactly one of range or recurring"},
+		},
+		{
+			name:        "Unsupported Rule",
+			requestBody: `{"schedule1": ` + fixedRange + `, "schedule2": {"recurring": {"dtstart": "2025-07-01T09:00:00Z", "duration": "1h", "rrule": "FREQ=HOURLY"}}}`,
+			expected:    map[string]string{"schedule2.recurring.rrule": `FREQ "HOURLY" is not supported, use DAILY, WEEKLY, MONTHLY or YEARLY`},
+		},
+		{
+			name:        "Zero Duration",
+			requestBody: `{"schedule1": ` + fixedRange + `, "schedule2": {"recurring": {"dtstart": "2025-07-01T09:00:00Z", "duration": "0s"}}}`,
+			expected:    map[string]string{"schedule2.recurring.duration": "is required"},
+		},
+		{
+			name:        "Negative Limit",
+			requestBody: `{"schedule1": ` + fixedRange + `, "schedule2": ` + fixedRange + `, "limit": -1}`,
+			expected:    map[string]string{"limit": "must be at least 0"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			router, mockService, mockLogger := setupTestRouter()
+			mockLogger.On("Errorf", "Unable to bind with json body :%v", mock.Anything).Return()
+
+			req, _ := http.NewRequest("POST", "/api/v1/recurring-overlap", strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+
+			var errorResponse response.ErrorResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &errorResponse))
+			assert.Equal(t, tc.expected, errorResponse.Error.Errors)
+
+			mockService.AssertNotCalled(t, "FindRecurringOverlaps", mock.Anything, mock.Anything, mock.Anything)
+			mockLogger.AssertExpectations(t)
+		})
+	}
+}
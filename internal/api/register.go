@@ -2,6 +2,7 @@ package api
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/keshu12345/overlap-avalara/config"
 	"github.com/keshu12345/overlap-avalara/internal/overlap"
 	"github.com/keshu12345/overlap-avalara/logger"
 )
@@ -10,14 +11,62 @@ var overlapService overlap.OverlapService
 
 var appLogger logger.Logger
 
-func RegisterEndpoint(g *gin.Engine, os overlap.OverlapService, logger logger.Logger) {
+// RegisterEndpoint wires the handlers. cfg may be nil, in which case the
+// default validation rules apply.
+func RegisterEndpoint(g *gin.Engine, os overlap.OverlapService, logger logger.Logger, cfg *config.Configuration) {
 
 	overlapService = os
 	appLogger = logger
+	binder := newRequestBinder(cfg)
 
-	v1 := g.Group("/api/v1")
+	v1 := g.Group("/api/v1", binder.attach)
 	{
 
 		v1.POST("/overlap-check", CheckOverlap)
+		v1.POST("/overlap-relation", ClassifyOverlap)
+		v1.POST("/overlap-pairs", FindOverlaps)
+		v1.POST("/dimensional-overlap-pairs", FindDimensionalOverlaps)
+		v1.POST("/interval-join", JoinRanges)
+		v1.POST("/concurrency", FindConcurrency)
+		v1.POST("/free-gaps", FindGaps)
+		v1.POST("/free-slots", FindSlots)
+		v1.POST("/conflict-resolution", ResolveConflicts)
+		v1.POST("/coverage-check", CheckCoverage)
+		v1.POST("/tax-rate-validation", ValidateTaxRates)
+		v1.POST("/proration", ProrateBillingPeriod)
+		v1.POST("/filing-periods", FindFilingPeriods)
+		v1.POST("/partition", PartitionRecords)
+		v1.POST("/recurring-overlap", FindRecurringOverlaps)
+		v1.POST("/zoned-overlap-check", CheckZonedOverlap)
+		v1.POST("/date-overlap-check", CheckDateOverlap)
+		v1.POST("/mixed-overlap-check", CheckMixedOverlap)
+		v1.POST("/business-overlap-check", CheckBusinessOverlap)
+		v1.GET("/calendars", ListCalendars)
+	}
+
+	rangeSet := v1.Group("/range-set")
+	{
+		rangeSet.POST("/union", UnionRanges)
+		rangeSet.POST("/intersection", IntersectRanges)
+		rangeSet.POST("/difference", SubtractRanges)
+		rangeSet.POST("/symmetric-difference", SymmetricDifferenceRanges)
+		rangeSet.POST("/complement", ComplementRanges)
+	}
+
+	intRange := v1.Group("/int-range")
+	{
+		intRange.POST("/overlap-check", CheckIntOverlap)
+		intRange.POST("/overlap-pairs", FindIntOverlaps)
+	}
+
+	decimalRange := v1.Group("/decimal-range")
+	{
+		decimalRange.POST("/overlap-check", CheckDecimalOverlap)
+		decimalRange.POST("/overlap-pairs", FindDecimalOverlaps)
+	}
+
+	v2 := g.Group("/api/v2", binder.attach)
+	{
+		v2.POST("/overlap-check", CheckOverlapV2)
 	}
 }
@@ -0,0 +1,21 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/pkg/response"
+)
+
+// ResolveConflicts suggests shifts and trims, cheapest first for each range,
+// that leave no two of the movable ranges overlapping each other or the fixed
+// ones.
+func ResolveConflicts(c *gin.Context) {
+	var req data.ResolveRequest
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	result := overlapService.ResolveConflicts(req.Ranges, req.ResolveOptions)
+	appLogger.Infof("suggested %d edits for %d ranges, %d conflicts left", len(result.Edits), len(req.Ranges), len(result.Conflicts))
+	response.NewSuccess(c, result)
+}
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveConflicts_ReturnsEdits(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	request := data.ResolveRequest{
+		Ranges: []data.ResolvableRange{
+			{ID: "board", Range: createDateRange("2025-07-01T10:00:00Z", "2025-07-01T12:00:00Z"), Fixed: true},
+			{ID: "sync", Range: createDateRange("2025-07-01T09:00:00Z", "2025-07-01T11:00:00Z")},
+		},
+		ResolveOptions: data.ResolveOptions{Edits: []data.EditKind{data.EditShiftEarlier, data.EditTrimEnd}},
+	}
+	moved := createDateRange("2025-07-01T08:00:00Z", "2025-07-01T10:00:00Z")
+	result := data.ResolutionResult{
+		Resolved: true,
+		Edits: []data.RangeEdit{{
+			Index: 1, ID: "sync", Kind: data.EditShiftEarlier,
+			From: request.Ranges[1].Range, To: moved, Cost: data.Duration(time.Hour),
+		}},
+		Ranges: []data.IdentifiedRange{
+			{ID: "board", Range: request.Ranges[0].Range},
+			{ID: "sync", Range: moved},
+		},
+		Cost:      data.Duration(time.Hour),
+		Conflicts: []data.OverlapPair{},
+		Boundary:  data.BoundaryClosedOpen,
+	}
+
+	mockService.On("ResolveConflicts", request.Ranges, request.ResolveOptions).Return(result)
+	mockLogger.On("Infof", "suggested %d edits for %d ranges, %d conflicts left", []interface{}{1, 2, 0}).Return()
+
+	requestBody, _ := json.Marshal(request)
+	req, _ := http.NewRequest("POST", "/api/v1/conflict-resolution", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"kind":"shift_earlier"`)
+	assert.Contains(t, w.Body.String(), `"cost":"1h0m0s"`)
+
+	var response struct {
+		Data data.ResolutionResult `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, result, response.Data)
+
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestResolveConflicts_InvalidRequests(t *testing.T) {
+	testCases := []struct {
+		name           string
+		requestBody    string
+		expectedErrors map[string]string
+	}{
+		{
+			name:           "No Ranges",
+			requestBody:    `{"ranges": []}`,
+			expectedErrors: map[string]string{"ranges": "must be at least 1"},
+		},
+		{
+			name:           "Unknown Edit",
+			requestBody:    `{"ranges": [{"range": {"start": "2025-07-01T09:00:00Z", "end": "2025-07-01T10:00:00Z"}}], "edits": ["delete"]}`,
+			expectedErrors: map[string]string{"edits[0]": "must be one of shift_later shift_earlier trim_start trim_end"},
+		},
+		{
+			name:           "Inverted Range",
+			requestBody:    `{"ranges": [{"range": {"start": "2025-07-01T10:00:00Z", "end": "2025-07-01T09:00:00Z"}}]}`,
+			expectedErrors: map[string]string{"ranges[0].range.end": "must not be before start"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			router, mockService, mockLogger := setupTestRouter()
+			mockLogger.On("Errorf", "Unable to bind with json body :%v", mock.Anything).Return()
+
+			req, _ := http.NewRequest("POST", "/api/v1/conflict-resolution", strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			for field, message := range tc.expectedErrors {
+				assert.Contains(t, w.Body.String(), `"`+field+`":"`+message+`"`)
+			}
+			mockLogger.AssertExpectations(t)
+			mockService.AssertNotCalled(t, "ResolveConflicts")
+		})
+	}
+}
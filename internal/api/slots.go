@@ -0,0 +1,24 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/pkg/response"
+)
+
+// FindSlots returns the earliest slots of a given length in which none of
+// the participants is busy.
+func FindSlots(c *gin.Context) {
+	var req data.SlotRequest
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	result, err := overlapService.FindSlots(req.Busy, req.Duration, *req.Window, req.SlotOptions)
+	if err != nil {
+		serviceError(c, err)
+		return
+	}
+	appLogger.Infof("found %d free slots for %d participants", len(result.Slots), len(req.Busy))
+	response.NewSuccess(c, result)
+}
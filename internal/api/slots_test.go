@@ -0,0 +1,154 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/internal/overlap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindSlots_ReturnsSlots(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	window := createDateRange("2025-07-01T09:00:00Z", "2025-07-01T17:00:00Z")
+	request := data.SlotRequest{
+		Busy: map[string][]data.DateRange{
+			"alice": {createDateRange("2025-07-01T09:00:00Z", "2025-07-01T10:00:00Z")},
+			"bob":   {createDateRange("2025-07-01T10:00:00Z", "2025-07-01T12:00:00Z")},
+		},
+		Duration: data.Duration(30 * time.Minute),
+		Window:   &window,
+		SlotOptions: data.SlotOptions{
+			WorkingHours: []data.WorkingHours{{
+				Days:  []string{"monday", "tuesday"},
+				Start: data.ClockTime(9 * time.Hour),
+				End:   data.ClockTime(17*time.Hour + 30*time.Minute),
+			}},
+			Limit: 1,
+		},
+	}
+	result := data.SlotResult{
+		Slots: []data.Slot{{
+			Range: createDateRange("2025-07-01T12:00:00Z", "2025-07-01T12:30:00Z"),
+			Free:  createDateRange("2025-07-01T12:00:00Z", "2025-07-01T17:00:00Z"),
+		}},
+		Boundary: data.BoundaryClosedOpen,
+	}
+
+	mockService.On("FindSlots", request.Busy, request.Duration, *request.Window, request.SlotOptions).Return(result, nil)
+	mockLogger.On("Infof", "found %d free slots for %d participants", []interface{}{1, 2}).Return()
+
+	requestBody, _ := json.Marshal(request)
+	assert.Contains(t, string(requestBody), `"start":"09:00","end":"17:30"`)
+
+	req, _ := http.NewRequest("POST", "/api/v1/free-slots", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		Data data.SlotResult `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, result, response.Data)
+
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestFindSlots_InvalidRequests(t *testing.T) {
+	base := `"busy": {"alice": []}, "duration": "30m", "window": {"start": "2025-07-01T09:00:00Z", "end": "2025-07-01T17:00:00Z"}`
+	testCases := []struct {
+		name           string
+		requestBody    string
+		expectedErrors map[string]string
+	}{
+		{
+			name:           "No Participants",
+			requestBody:    `{"busy": {}, "duration": "30m", "window": {"start": "2025-07-01T09:00:00Z", "end": "2025-07-01T17:00:00Z"}}`,
+			expectedErrors: map[string]string{"busy": "must be at least 1"},
+		},
+		{
+			name:           "Missing Duration",
+			requestBody:    `{"busy": {"alice": []}, "window": {"start": "2025-07-01T09:00:00Z", "end": "2025-07-01T17:00:00Z"}}`,
+			expectedErrors: map[string]string{"duration": "is required"},
+		},
+		{
+			name:           "Inverted Busy Range",
+			requestBody:    `{"busy": {"alice": [{"start": "2025-07-01T11:00:00Z", "end": "2025-07-01T10:00:00Z"}]}, "duration": "30m", "window": {"start": "2025-07-01T09:00:00Z", "end": "2025-07-01T17:00:00Z"}}`,
+			expectedErrors: map[string]string{"busy[alice][0].end": "must not be before start"},
+		},
+		{
+			name:           "Working Hours End Before Start",
+			requestBody:    `{` + base + `, "working_hours": [{"start": "17:00", "end": "09:00"}]}`,
+			expectedErrors: map[string]string{"working_hours[0].end": "must be after start"},
+		},
+		{
+			name:           "Unknown Weekday",
+			requestBody:    `{` + base + `, "working_hours": [{"days": ["funday"], "start": "09:00", "end": "17:00"}]}`,
+			expectedErrors: map[string]string{"working_hours[0].days[0]": "must be one of sunday monday tuesday wednesday thursday friday saturday"},
+		},
+		{
+			name:           "Unknown Zone",
+			requestBody:    `{` + base + `, "zone": "Mars/Olympus_Mons"}`,
+			expectedErrors: map[string]string{"zone": "must be an IANA time zone such as America/New_York"},
+		},
+		{
+			name:        "Bad Time Of Day",
+			requestBody: `{` + base + `, "working_hours": [{"start": "9am", "end": "17:00"}]}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			router, mockService, mockLogger := setupTestRouter()
+			mockLogger.On("Errorf", "Unable to bind with json body :%v", mock.Anything).Return()
+
+			req, _ := http.NewRequest("POST", "/api/v1/free-slots", strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			for field, message := range tc.expectedErrors {
+				assert.Contains(t, w.Body.String(), `"`+field+`":"`+message+`"`)
+			}
+			mockLogger.AssertExpectations(t)
+			mockService.AssertNotCalled(t, "FindSlots")
+		})
+	}
+}
+
+func TestFindSlots_ServiceError(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	mockService.On("FindSlots", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(data.SlotResult{}, &overlap.FieldError{Field: "calendar", Message: "unknown calendar Atlantis"})
+	mockLogger.On("Errorf", "Unable to complete the request :%v", mock.Anything).Return()
+
+	body := `{"busy": {"alice": []}, "duration": "30m", "calendar": "Atlantis",
+		"window": {"start": "2025-07-01T09:00:00Z", "end": "2025-07-01T17:00:00Z"}}`
+	req, _ := http.NewRequest("POST", "/api/v1/free-slots", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), `"calendar":"unknown calendar Atlantis"`)
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
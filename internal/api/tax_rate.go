@@ -0,0 +1,21 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/pkg/response"
+)
+
+// ValidateTaxRates reports the conflicting, missing and duplicated periods in
+// a file of tax rate rows, per jurisdiction. A file with problems is still a
+// valid request, so the report comes back with a 200 and valid set to false.
+func ValidateTaxRates(c *gin.Context) {
+	var req data.TaxRateValidationRequest
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	report := overlapService.ValidateTaxRates(req.Rows, req.Window, req.OverlapOptions)
+	appLogger.Infof("tax rates valid: %v, %d rows in %d jurisdictions", report.Valid, len(req.Rows), len(report.Jurisdictions))
+	response.NewSuccess(c, report)
+}
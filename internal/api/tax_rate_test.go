@@ -0,0 +1,118 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTaxRates_ReturnsReport(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	first, second := decimal("7.25"), decimal("7.5")
+	request := data.TaxRateValidationRequest{
+		Rows: []data.TaxRateRow{
+			{ID: "ca-1", Jurisdiction: "CA", Rate: &first, Range: createDateRange("2024-01-01T00:00:00Z", "2025-01-01T00:00:00Z")},
+			{ID: "ca-2", Jurisdiction: "CA", Rate: &second, Range: createDateRange("2024-12-01T00:00:00Z", "2026-01-01T00:00:00Z")},
+		},
+	}
+	overlap := createDateRange("2024-12-01T00:00:00Z", "2025-01-01T00:00:00Z")
+	report := data.TaxRateReport{
+		Jurisdictions: []data.JurisdictionReport{{
+			Jurisdiction: "CA",
+			Rows:         []int{0, 1},
+			Conflicts: []data.RateConflict{{
+				FirstIndex: 0, FirstID: "ca-1", FirstRate: first,
+				SecondIndex: 1, SecondID: "ca-2", SecondRate: second,
+				Intersection: overlap, Duration: overlap.Duration(),
+			}},
+			Gaps:       []data.RateGap{},
+			Duplicates: []data.RateDuplicate{},
+		}},
+		Boundary: data.BoundaryClosedOpen,
+	}
+
+	mockService.On("ValidateTaxRates", request.Rows, request.Window, request.OverlapOptions).Return(report)
+	mockLogger.On("Infof", "tax rates valid: %v, %d rows in %d jurisdictions", []interface{}{false, 2, 1}).Return()
+
+	requestBody, _ := json.Marshal(request)
+	req, _ := http.NewRequest("POST", "/api/v1/tax-rate-validation", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"first_rate":"7.25","second_index":1,"second_id":"ca-2","second_rate":"7.5"`)
+
+	var response struct {
+		Data data.TaxRateReport `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, report, response.Data)
+
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestValidateTaxRates_InvalidRequests(t *testing.T) {
+	period := `"range": {"start": "2024-01-01T00:00:00Z", "end": "2025-01-01T00:00:00Z"}`
+	testCases := []struct {
+		name           string
+		requestBody    string
+		expectedErrors map[string]string
+	}{
+		{
+			name:           "No Rows",
+			requestBody:    `{"rows": []}`,
+			expectedErrors: map[string]string{"rows": "must be at least 1"},
+		},
+		{
+			name:           "Missing Rate",
+			requestBody:    `{"rows": [{"jurisdiction": "CA", ` + period + `}]}`,
+			expectedErrors: map[string]string{"rows[0].rate": "is required"},
+		},
+		{
+			name:           "Missing Jurisdiction",
+			requestBody:    `{"rows": [{"rate": "7.25", ` + period + `}]}`,
+			expectedErrors: map[string]string{"rows[0].jurisdiction": "is required"},
+		},
+		{
+			name:           "Inverted Window",
+			requestBody:    `{"rows": [{"jurisdiction": "CA", "rate": "7.25", ` + period + `}], "window": {"start": "2026-01-01T00:00:00Z", "end": "2024-01-01T00:00:00Z"}}`,
+			expectedErrors: map[string]string{"window.end": "must not be before start"},
+		},
+		{
+			name:        "Bad Rate",
+			requestBody: `{"rows": [{"jurisdiction": "CA", "rate": "seven", ` + period + `}]}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			router, mockService, mockLogger := setupTestRouter()
+			mockLogger.On("Errorf", "Unable to bind with json body :%v", mock.Anything).Return()
+
+			req, _ := http.NewRequest("POST", "/api/v1/tax-rate-validation", strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			for field, message := range tc.expectedErrors {
+				assert.Contains(t, w.Body.String(), `"`+field+`":"`+message+`"`)
+			}
+			mockLogger.AssertExpectations(t)
+			mockService.AssertNotCalled(t, "ValidateTaxRates")
+		})
+	}
+}
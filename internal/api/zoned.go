@@ -0,0 +1,23 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/pkg/response"
+)
+
+// CheckZonedOverlap intersects two wall-clock ranges given in named time zones.
+func CheckZonedOverlap(c *gin.Context) {
+	var req data.ZonedOverlapRequest
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	result, err := overlapService.IntersectZoned(req.Range1, req.Range2, req.ZoneOptions)
+	if err != nil {
+		serviceError(c, err)
+		return
+	}
+	appLogger.Infof("zoned overlap result for the time range %+v", result)
+	response.NewSuccess(c, result)
+}
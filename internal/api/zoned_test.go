@@ -0,0 +1,181 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/internal/overlap"
+	"github.com/keshu12345/overlap-avalara/pkg/response"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func localTime(s string) data.LocalDateTime {
+	t, _ := time.Parse("2006-01-02T15:04:05", s)
+	return data.LocalDateTime(t)
+}
+
+func TestCheckZonedOverlap_ReturnsResult(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	r1 := data.ZonedRange{Start: localTime("2025-03-09T09:00:00"), End: localTime("2025-03-09T17:00:00"), Zone: "America/New_York"}
+	r2 := data.ZonedRange{Start: localTime("2025-03-09T14:00:00"), Zone: "Europe/London"}
+	opts := data.ZoneOptions{OutputZone: "UTC", Nonexistent: data.GapReject, Ambiguous: data.FoldLater}
+
+	intersection := createDateRange("2025-03-09T14:00:00Z", "2025-03-09T21:00:00Z")
+	result := data.ZonedOverlapResult{
+		OverlapResult: data.OverlapResult{
+			Overlap:        true,
+			Intersection:   &intersection,
+			Duration:       data.Duration(7 * time.Hour),
+			Range1Coverage: 0.875,
+			Boundary:       data.BoundaryClosedOpen,
+		},
+		Range1:     createDateRange("2025-03-09T13:00:00Z", "2025-03-09T21:00:00Z"),
+		Range2:     data.DateRange{Start: parseTime("2025-03-09T14:00:00Z")},
+		OutputZone: "UTC",
+	}
+
+	mockService.On("IntersectZoned", r1, r2, opts).Return(result, nil)
+	mockLogger.On("Infof", "zoned overlap result for the time range %+v", mock.Anything).Return()
+
+	body := `{
+		"range1": {"start": "2025-03-09T09:00:00", "end": "2025-03-09T17:00:00", "zone": "America/New_York"},
+		"range2": {"start": "2025-03-09T14:00:00", "end": null, "zone": "Europe/London"},
+		"output_zone": "UTC", "nonexistent": "reject", "ambiguous": "later"
+	}`
+	req, _ := http.NewRequest("POST", "/api/v1/zoned-overlap-check", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"output_zone":"UTC"`)
+	assert.Contains(t, w.Body.String(), `"overlap":true`)
+
+	var response struct {
+		Data data.ZonedOverlapResult `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, result, response.Data)
+
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestCheckZonedOverlap_ServiceFieldError(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+
+	mockService.On("IntersectZoned", mock.Anything, mock.Anything, mock.Anything).Return(
+		data.ZonedOverlapResult{},
+		&overlap.FieldError{Field: "range1.start", Message: "2025-03-09T02:30:00 does not exist in America/New_York, clocks skip it"},
+	)
+	mockLogger.On("Errorf", "Unable to complete the request :%v", mock.Anything).Return()
+
+	body := `{
+		"range1": {"start": "2025-03-09T02:30:00", "end": "2025-03-09T04:00:00", "zone": "America/New_York"},
+		"range2": {"start": "2025-03-09T00:00:00", "end": "2025-03-10T00:00:00", "zone": "UTC"},
+		"nonexistent": "reject"
+	}`
+	req, _ := http.NewRequest("POST", "/api/v1/zoned-overlap-check", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var errorResponse response.ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &errorResponse))
+	assert.Equal(t, map[string]string{
+		"range1.start": "2025-03-09T02:30:00 does not exist in America/New_York, clocks skip it",
+	}, errorResponse.Error.Errors)
+
+	mockService.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestCheckZonedOverlap_InvalidRequests(t *testing.T) {
+	valid := `{"start": "2025-07-01T09:00:00", "end": "2025-07-01T17:00:00", "zone": "UTC"}`
+	testCases := []struct {
+		name        string
+		requestBody string
+		expected    map[string]string
+	}{
+		{
+			name:        "Unknown Zone",
+			requestBody: `{"range1": {"start": "2025-07-01T09:00:00", "end": null, "zone": "Mars/Olympus_Mons"}, "range2": ` + valid + `}`,
+			expected:    map[string]string{"range1.zone": "must be an IANA time zone such as America/New_York"},
+		},
+		{
+			name:        "Missing Zone",
+			requestBody: `{"range1": {"start": "2025-07-01T09:00:00", "end": null}, "range2": ` + valid + `}`,
+			expected:    map[string]string{"range1.zone": "is required"},
+		},
+		{
+			name:        "Inverted Wall Clock",
+			requestBody: `{"range1": {"start": "2025-07-01T17:00:00", "end": "2025-07-01T09:00:00", "zone": "UTC"}, "range2": ` + valid + `}`,
+			expected:    map[string]string{"range1.end": "must not be before start"},
+		},
+		{
+			name:        "Zero Wall Clock As A Bound",
+			requestBody: `{"range1": {"start": "0001-01-01T00:00:00", "end": "2025-07-01T09:00:00", "zone": "UTC"}, "range2": ` + valid + `}`,
+		},
+		{
+			name:        "Unknown Output Zone",
+			requestBody: `{"range1": ` + valid + `, "range2": ` + valid + `, "output_zone": "Local"}`,
+			expected:    map[string]string{"output_zone": "must be an IANA time zone such as America/New_York"},
+		},
+		{
+			name:        "Unknown Policy",
+			requestBody: `{"range1": ` + valid + `, "range2": ` + valid + `, "ambiguous": "whichever"}`,
+			expected:    map[string]string{"ambiguous": "must be one of earlier later reject"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			router, mockService, mockLogger := setupTestRouter()
+			mockLogger.On("Errorf", "Unable to bind with json body :%v", mock.Anything).Return()
+
+			req, _ := http.NewRequest("POST", "/api/v1/zoned-overlap-check", strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+
+			var errorResponse response.ErrorResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &errorResponse))
+			assert.Equal(t, tc.expected, errorResponse.Error.Errors)
+
+			mockService.AssertNotCalled(t, "IntersectZoned", mock.Anything, mock.Anything, mock.Anything)
+			mockLogger.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCheckZonedOverlap_RejectsOffsets(t *testing.T) {
+	router, mockService, mockLogger := setupTestRouter()
+	mockLogger.On("Errorf", "Unable to bind with json body :%v", mock.Anything).Return()
+
+	body := `{"range1": {"start": "2025-07-01T09:00:00-04:00", "end": null, "zone": "America/New_York"},
+		"range2": {"start": "2025-07-01T09:00:00", "end": null, "zone": "UTC"}}`
+	req, _ := http.NewRequest("POST", "/api/v1/zoned-overlap-check", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "IntersectZoned", mock.Anything, mock.Anything, mock.Anything)
+	mockLogger.AssertExpectations(t)
+}
@@ -0,0 +1,90 @@
+// Package calendar holds the named holiday calendars used for business-day
+// calculations. Each calendar is one file under the calendars directory of
+// the config dir, listing a region's weekend days and dated holidays.
+package calendar
+
+import (
+	"sort"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+)
+
+// Calendar says which days of a region are working days. Days are read in
+// Location, so an instant belongs to the day it falls on there.
+type Calendar struct {
+	Name     string
+	Location *time.Location
+	weekend  map[time.Weekday]bool
+	holidays map[data.CivilDate]string
+}
+
+// New builds a calendar. A nil location means UTC.
+func New(name string, loc *time.Location, weekend []time.Weekday, holidays map[data.CivilDate]string) *Calendar {
+	if loc == nil {
+		loc = time.UTC
+	}
+	c := &Calendar{
+		Name:     name,
+		Location: loc,
+		weekend:  make(map[time.Weekday]bool, len(weekend)),
+		holidays: make(map[data.CivilDate]string, len(holidays)),
+	}
+	for _, wd := range weekend {
+		c.weekend[wd] = true
+	}
+	for d, holiday := range holidays {
+		c.holidays[d] = holiday
+	}
+	return c
+}
+
+// IsWorkingDay reports whether d is neither a weekend day nor a holiday.
+func (c *Calendar) IsWorkingDay(d data.CivilDate) bool {
+	if c.weekend[d.Midnight().Weekday()] {
+		return false
+	}
+	_, holiday := c.holidays[d]
+	return !holiday
+}
+
+// Holiday returns the name of the holiday on d, if there is one.
+func (c *Calendar) Holiday(d data.CivilDate) (string, bool) {
+	name, ok := c.holidays[d]
+	return name, ok
+}
+
+// Registry is the set of calendars known to the service, by name.
+type Registry struct {
+	calendars map[string]*Calendar
+}
+
+func NewRegistry(calendars ...*Calendar) *Registry {
+	r := &Registry{calendars: make(map[string]*Calendar, len(calendars))}
+	for _, c := range calendars {
+		r.calendars[c.Name] = c
+	}
+	return r
+}
+
+// Get returns the calendar called name. A nil registry holds no calendars.
+func (r *Registry) Get(name string) (*Calendar, bool) {
+	if r == nil {
+		return nil, false
+	}
+	c, ok := r.calendars[name]
+	return c, ok
+}
+
+// Names returns the names of all calendars, sorted.
+func (r *Registry) Names() []string {
+	names := make([]string, 0)
+	if r == nil {
+		return names
+	}
+	for name := range r.calendars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,129 @@
+package calendar
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/config"
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockLogger struct {
+	mock.Mock
+}
+
+func (m *MockLogger) Info(args ...interface{})                  { m.Called(args...) }
+func (m *MockLogger) Infof(format string, args ...interface{})  { m.Called(format, args) }
+func (m *MockLogger) Error(args ...interface{})                 { m.Called(args...) }
+func (m *MockLogger) Errorf(format string, args ...interface{}) { m.Called(format, args) }
+func (m *MockLogger) Warn(args ...interface{})                  { m.Called(args...) }
+func (m *MockLogger) Warnf(format string, args ...interface{})  { m.Called(format, args) }
+func (m *MockLogger) Debug(args ...interface{})                 { m.Called(args...) }
+func (m *MockLogger) Debugf(format string, args ...interface{}) { m.Called(format, args) }
+
+func writeCalendar(t *testing.T, dir, name, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	writeCalendar(t, dir, "uae.yml", `
+name: AE
+zone: Asia/Dubai
+weekend: [Saturday, Sunday]
+holidays:
+  - date: "2025-12-02"
+    name: National Day
+`)
+	writeCalendar(t, dir, "IL.yaml", `
+weekend: [friday, saturday]
+`)
+	writeCalendar(t, dir, "README.md", "not a calendar")
+
+	registry, err := Load(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"AE", "IL"}, registry.Names())
+
+	ae, ok := registry.Get("AE")
+	require.True(t, ok)
+	assert.Equal(t, "Asia/Dubai", ae.Location.String())
+	assert.False(t, ae.IsWorkingDay(data.NewCivilDate(2025, time.December, 2)))
+	assert.True(t, ae.IsWorkingDay(data.NewCivilDate(2025, time.December, 3)))
+	assert.False(t, ae.IsWorkingDay(data.NewCivilDate(2025, time.December, 6)))
+	name, ok := ae.Holiday(data.NewCivilDate(2025, time.December, 2))
+	assert.True(t, ok)
+	assert.Equal(t, "National Day", name)
+
+	il, ok := registry.Get("IL")
+	require.True(t, ok)
+	assert.Equal(t, time.UTC, il.Location)
+	assert.False(t, il.IsWorkingDay(data.NewCivilDate(2025, time.December, 5)))
+	assert.True(t, il.IsWorkingDay(data.NewCivilDate(2025, time.December, 7)))
+
+	_, ok = registry.Get("US")
+	assert.False(t, ok)
+}
+
+func TestLoad_MissingDirectory(t *testing.T) {
+	registry, err := Load(filepath.Join(t.TempDir(), "calendars"))
+
+	require.NoError(t, err)
+	assert.Empty(t, registry.Names())
+}
+
+func TestLoad_Errors(t *testing.T) {
+	testCases := []struct {
+		name  string
+		files map[string]string
+	}{
+		{name: "Unknown Weekday", files: map[string]string{"a.yml": "weekend: [caturday]"}},
+		{name: "Bad Holiday Date", files: map[string]string{"a.yml": "holidays:\n  - date: 12/25/2025\n    name: Christmas"}},
+		{name: "Unknown Zone", files: map[string]string{"a.yml": "zone: Nowhere/Special"}},
+		{name: "Duplicate Name", files: map[string]string{"a.yml": "name: X", "b.yml": "name: X"}},
+		{name: "Broken YAML", files: map[string]string{"a.yml": "weekend: [saturday"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tc.files {
+				writeCalendar(t, dir, name, content)
+			}
+
+			_, err := Load(dir)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNewRegistryFromConfig_ShippedCalendars(t *testing.T) {
+	for _, env := range []string{"local", "nonprod", "prod"} {
+		t.Run(env, func(t *testing.T) {
+			mockLogger := &MockLogger{}
+			dir := filepath.Join("..", "..", "config", env, "calendars")
+			mockLogger.On("Infof", "loaded %d holiday calendars from %s", []interface{}{2, dir}).Return()
+
+			registry, err := NewRegistryFromConfig(&config.Configuration{Calendar: config.Calendar{Dir: dir}}, mockLogger)
+
+			require.NoError(t, err)
+			assert.Equal(t, []string{"GB", "US"}, registry.Names())
+			us, _ := registry.Get("US")
+			assert.False(t, us.IsWorkingDay(data.NewCivilDate(2025, time.July, 4)))
+			mockLogger.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRegistry_Nil(t *testing.T) {
+	var registry *Registry
+
+	_, ok := registry.Get("US")
+	assert.False(t, ok)
+	assert.Empty(t, registry.Names())
+}
@@ -0,0 +1,136 @@
+package calendar
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/config"
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/internal/zone"
+	"github.com/keshu12345/overlap-avalara/logger"
+	"github.com/spf13/viper"
+)
+
+// file is the layout of a calendar file:
+//
+//	name: US                      # defaults to the file name
+//	zone: America/New_York        # defaults to UTC
+//	weekend: [saturday, sunday]
+//	holidays:
+//	  - date: "2025-07-04"
+//	    name: Independence Day
+type file struct {
+	Name     string    `mapstructure:"name"`
+	Zone     string    `mapstructure:"zone"`
+	Weekend  []string  `mapstructure:"weekend"`
+	Holidays []holiday `mapstructure:"holidays"`
+}
+
+type holiday struct {
+	Date string `mapstructure:"date"`
+	Name string `mapstructure:"name"`
+}
+
+// NewRegistryFromConfig loads the calendars from cfg.Calendar.Dir. A missing
+// directory leaves the registry empty; a broken file stops startup.
+func NewRegistryFromConfig(cfg *config.Configuration, logger logger.Logger) (*Registry, error) {
+	registry, err := Load(cfg.Calendar.Dir)
+	if err != nil {
+		return nil, err
+	}
+	logger.Infof("loaded %d holiday calendars from %s", len(registry.calendars), cfg.Calendar.Dir)
+	return registry, nil
+}
+
+// Load reads every .yml and .yaml file in dir as a calendar.
+func Load(dir string) (*Registry, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return NewRegistry(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	calendars := make([]*Calendar, 0, len(entries))
+	seen := make(map[string]string)
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".yml" && ext != ".yaml") {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		c, err := loadFile(path, strings.TrimSuffix(entry.Name(), ext))
+		if err != nil {
+			return nil, fmt.Errorf("calendar %s: %w", path, err)
+		}
+		if other, ok := seen[c.Name]; ok {
+			return nil, fmt.Errorf("calendar %q is defined in both %s and %s", c.Name, other, path)
+		}
+		seen[c.Name] = path
+		calendars = append(calendars, c)
+	}
+	return NewRegistry(calendars...), nil
+}
+
+func loadFile(path, defaultName string) (*Calendar, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+	var f file
+	if err := v.Unmarshal(&f); err != nil {
+		return nil, err
+	}
+	if f.Name == "" {
+		f.Name = defaultName
+	}
+
+	var loc *time.Location
+	if f.Zone != "" {
+		var err error
+		if loc, err = zone.Load(f.Zone); err != nil {
+			return nil, err
+		}
+	}
+
+	weekend := make([]time.Weekday, 0, len(f.Weekend))
+	for _, name := range f.Weekend {
+		wd, ok := Weekday(name)
+		if !ok {
+			return nil, fmt.Errorf("weekend day %q is not a weekday", name)
+		}
+		weekend = append(weekend, wd)
+	}
+
+	holidays := make(map[data.CivilDate]string, len(f.Holidays))
+	for _, h := range f.Holidays {
+		t, err := time.Parse("2006-01-02", h.Date)
+		if err != nil {
+			return nil, fmt.Errorf("holiday %q date %q must look like 2025-07-04", h.Name, h.Date)
+		}
+		holidays[data.CivilDate(t)] = h.Name
+	}
+	return New(f.Name, loc, weekend, holidays), nil
+}
+
+// Weekday returns the day of the week called name, such as "saturday",
+// ignoring case.
+func Weekday(name string) (time.Weekday, bool) {
+	wd, ok := weekdays[strings.ToLower(name)]
+	return wd, ok
+}
+
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
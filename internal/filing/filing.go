@@ -0,0 +1,122 @@
+// Package filing generates the filing periods of a tax calendar: months,
+// quarters, halves or years, counted from the month a fiscal year begins.
+package filing
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+)
+
+// Period is one filing period, from midnight of its first day up to midnight
+// of the first day of the next period.
+type Period struct {
+	Label      string
+	FiscalYear int
+	Start      time.Time
+	End        time.Time
+}
+
+// Calendar generates filing periods of one frequency. Periods begin at
+// midnight in Location.
+type Calendar struct {
+	Frequency       data.FilingFrequency
+	FiscalYearStart time.Month
+	Location        *time.Location
+}
+
+// New builds a calendar. A fiscal year start of zero means January and a nil
+// location means UTC.
+func New(freq data.FilingFrequency, fiscalYearStart time.Month, loc *time.Location) Calendar {
+	if fiscalYearStart == 0 {
+		fiscalYearStart = time.January
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	return Calendar{Frequency: freq, FiscalYearStart: fiscalYearStart, Location: loc}
+}
+
+// Periods returns, in order, the periods from the one holding from up to the
+// one holding to, both included. It stops after limit periods and reports
+// false when more would follow; a limit of zero means no limit.
+func (c Calendar) Periods(from, to time.Time, limit int) ([]Period, bool) {
+	length := c.months()
+	offset := int(c.FiscalYearStart) - 1
+
+	periods := make([]Period, 0)
+	first := floorDiv(monthIndex(from.In(c.Location))-offset, length)*length + offset
+	for start := first; ; start += length {
+		p := c.period(start, length, offset)
+		if p.Start.After(to) {
+			return periods, true
+		}
+		if limit > 0 && len(periods) == limit {
+			return periods, false
+		}
+		periods = append(periods, p)
+	}
+}
+
+// period builds the period that starts in the month with the given index,
+// counted from January of year zero.
+func (c Calendar) period(start, length, offset int) Period {
+	yearStart := floorDiv(start-offset, 12)*12 + offset
+	fiscalYear := floorDiv(yearStart+11, 12)
+
+	prefix := fmt.Sprint(fiscalYear)
+	if c.FiscalYearStart != time.January {
+		prefix = fmt.Sprintf("FY%d", fiscalYear)
+	}
+	var label string
+	switch c.Frequency {
+	case data.FilingMonthly:
+		label = fmt.Sprintf("%04d-%02d", floorDiv(start, 12), start-floorDiv(start, 12)*12+1)
+	case data.FilingQuarterly:
+		label = fmt.Sprintf("%s-Q%d", prefix, (start-yearStart)/3+1)
+	case data.FilingSemiAnnual:
+		label = fmt.Sprintf("%s-H%d", prefix, (start-yearStart)/6+1)
+	default:
+		label = prefix
+	}
+	return Period{
+		Label:      label,
+		FiscalYear: fiscalYear,
+		Start:      c.firstOfMonth(start),
+		End:        c.firstOfMonth(start + length),
+	}
+}
+
+func (c Calendar) months() int {
+	switch c.Frequency {
+	case data.FilingMonthly:
+		return 1
+	case data.FilingQuarterly:
+		return 3
+	case data.FilingSemiAnnual:
+		return 6
+	default:
+		return 12
+	}
+}
+
+// firstOfMonth returns midnight of the first day of the month with the given
+// index. time.Date normalizes the month into the year.
+func (c Calendar) firstOfMonth(index int) time.Time {
+	return time.Date(0, time.Month(index+1), 1, 0, 0, 0, 0, c.Location)
+}
+
+func monthIndex(t time.Time) int {
+	return t.Year()*12 + int(t.Month()) - 1
+}
+
+// floorDiv divides rounding towards negative infinity, so months before year
+// zero still fall in the right period.
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
@@ -0,0 +1,80 @@
+package filing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func labels(periods []Period) []string {
+	out := make([]string, len(periods))
+	for i, p := range periods {
+		out[i] = p.Label
+	}
+	return out
+}
+
+func TestCalendar_Periods(t *testing.T) {
+	testCases := []struct {
+		name     string
+		freq     data.FilingFrequency
+		fiscal   time.Month
+		from, to time.Time
+		expected []string
+	}{
+		{"Monthly Across New Year", data.FilingMonthly, 0, date(2025, 12, 20), date(2026, 1, 5), []string{"2025-12", "2026-01"}},
+		{"Calendar Quarters", data.FilingQuarterly, 0, date(2025, 2, 15), date(2025, 8, 1), []string{"2025-Q1", "2025-Q2", "2025-Q3"}},
+		{"Fiscal Quarters From July", data.FilingQuarterly, time.July, date(2025, 6, 15), date(2025, 7, 15), []string{"FY2025-Q4", "FY2026-Q1"}},
+		{"Fiscal Halves From April", data.FilingSemiAnnual, time.April, date(2025, 3, 1), date(2025, 4, 1), []string{"FY2025-H2", "FY2026-H1"}},
+		{"Calendar Years", data.FilingAnnual, time.January, date(2024, 6, 1), date(2025, 6, 1), []string{"2024", "2025"}},
+		{"Fiscal Year From October", data.FilingAnnual, time.October, date(2025, 9, 30), date(2025, 9, 30), []string{"FY2025"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			periods, complete := New(tc.freq, tc.fiscal, nil).Periods(tc.from, tc.to, 0)
+
+			assert.True(t, complete)
+			assert.Equal(t, tc.expected, labels(periods))
+			for i := 1; i < len(periods); i++ {
+				assert.Equal(t, periods[i-1].End, periods[i].Start, "periods follow each other without gaps")
+			}
+		})
+	}
+}
+
+func TestCalendar_PeriodBounds(t *testing.T) {
+	periods, _ := New(data.FilingAnnual, time.October, nil).Periods(date(2025, 9, 30), date(2025, 9, 30), 0)
+
+	require.Len(t, periods, 1)
+	assert.Equal(t, date(2024, 10, 1), periods[0].Start)
+	assert.Equal(t, date(2025, 10, 1), periods[0].End)
+	assert.Equal(t, 2025, periods[0].FiscalYear)
+}
+
+func TestCalendar_PeriodsInZone(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	// 03:00 UTC on 1 April is still 31 March in New York.
+	from := time.Date(2025, 4, 1, 3, 0, 0, 0, time.UTC)
+	periods, _ := New(data.FilingQuarterly, 0, ny).Periods(from, from, 0)
+
+	require.Len(t, periods, 1)
+	assert.Equal(t, "2025-Q1", periods[0].Label)
+	assert.Equal(t, "2025-04-01T04:00:00Z", periods[0].End.UTC().Format(time.RFC3339))
+}
+
+func TestCalendar_PeriodsLimit(t *testing.T) {
+	periods, complete := New(data.FilingMonthly, 0, nil).Periods(date(2025, 1, 1), date(2027, 1, 1), 5)
+
+	assert.False(t, complete)
+	assert.Equal(t, []string{"2025-01", "2025-02", "2025-03", "2025-04", "2025-05"}, labels(periods))
+}
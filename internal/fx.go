@@ -2,6 +2,7 @@ package internal
 
 import (
 	"github.com/keshu12345/overlap-avalara/internal/api"
+	"github.com/keshu12345/overlap-avalara/internal/calendar"
 	"github.com/keshu12345/overlap-avalara/internal/overlap"
 	"go.uber.org/fx"
 )
@@ -9,4 +10,5 @@ import (
 var Module = fx.Options(
 	fx.Invoke(api.RegisterEndpoint),
 	fx.Provide(overlap.New),
+	fx.Provide(calendar.NewRegistryFromConfig),
 )
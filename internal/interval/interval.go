@@ -0,0 +1,171 @@
+// Package interval is the overlap engine behind every range type of the
+// service. It works on intervals over any ordered type, given a comparison,
+// so time ranges, numeric brackets and version ranges share one
+// implementation of boundary modes, intersections and range-set algebra.
+package interval
+
+import (
+	"cmp"
+
+	"github.com/keshu12345/overlap-avalara/data"
+)
+
+// Interval runs from Start to End. A nil Start is negative infinity and a nil
+// End positive infinity. Whether the endpoints themselves belong to the
+// interval is decided by the boundary mode of each operation.
+type Interval[T any] struct {
+	Start *T
+	End   *T
+}
+
+// Engine implements the interval operations for one endpoint type.
+type Engine[T any] struct {
+	compare func(a, b T) int
+}
+
+// New returns an engine that orders endpoints with compare, which returns a
+// negative number, zero or a positive number as a is before, equal to or
+// after b.
+func New[T any](compare func(a, b T) int) Engine[T] {
+	return Engine[T]{compare: compare}
+}
+
+// Ordered returns an engine for the built-in ordered types.
+func Ordered[T cmp.Ordered]() Engine[T] {
+	return New(cmp.Compare[T])
+}
+
+// CompareStarts orders two lower bounds.
+func (e Engine[T]) CompareStarts(a, b *T) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+	return e.compare(*a, *b)
+}
+
+// CompareEnds orders two upper bounds.
+func (e Engine[T]) CompareEnds(a, b *T) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+	return e.compare(*a, *b)
+}
+
+// StartBeforeEnd reports whether lower bound s lies strictly before upper bound end.
+func (e Engine[T]) StartBeforeEnd(s, end *T) bool {
+	return s == nil || end == nil || e.compare(*s, *end) < 0
+}
+
+// EndBeforeStart reports whether upper bound end lies strictly before lower bound s.
+func (e Engine[T]) EndBeforeStart(end, s *T) bool {
+	return end != nil && s != nil && e.compare(*end, *s) < 0
+}
+
+// EndMeetsStart reports whether upper bound end and lower bound s are the same point.
+func (e Engine[T]) EndMeetsStart(end, s *T) bool {
+	return end != nil && s != nil && e.compare(*end, *s) == 0
+}
+
+func (e Engine[T]) EarlierStart(a, b *T) *T {
+	if e.CompareStarts(a, b) < 0 {
+		return a
+	}
+	return b
+}
+
+func (e Engine[T]) LaterStart(a, b *T) *T {
+	if e.CompareStarts(a, b) > 0 {
+		return a
+	}
+	return b
+}
+
+func (e Engine[T]) EarlierEnd(a, b *T) *T {
+	if e.CompareEnds(a, b) < 0 {
+		return a
+	}
+	return b
+}
+
+func (e Engine[T]) LaterEnd(a, b *T) *T {
+	if e.CompareEnds(a, b) > 0 {
+		return a
+	}
+	return b
+}
+
+// IsEmpty reports whether r contains no point under boundary b. Only a closed
+// interval can hold a single point; the other modes need Start < End.
+func (e Engine[T]) IsEmpty(r Interval[T], b data.Boundary) bool {
+	if b == data.BoundaryClosed {
+		return e.EndBeforeStart(r.End, r.Start)
+	}
+	return !e.StartBeforeEnd(r.Start, r.End)
+}
+
+// Intersection returns the points shared by r1 and r2 under boundary b.
+// Both intervals use the same boundary mode, so the result uses it too.
+func (e Engine[T]) Intersection(r1, r2 Interval[T], b data.Boundary) (Interval[T], bool) {
+	if e.IsEmpty(r1, b) || e.IsEmpty(r2, b) {
+		return Interval[T]{}, false
+	}
+	r := Interval[T]{Start: e.LaterStart(r1.Start, r2.Start), End: e.EarlierEnd(r1.End, r2.End)}
+	if e.IsEmpty(r, b) {
+		return Interval[T]{}, false
+	}
+	return r, true
+}
+
+// Span returns the smallest interval holding both r1 and r2.
+func (e Engine[T]) Span(r1, r2 Interval[T]) Interval[T] {
+	return Interval[T]{Start: e.EarlierStart(r1.Start, r2.Start), End: e.LaterEnd(r1.End, r2.End)}
+}
+
+// Relate classifies how r1 relates to r2 using Allen's interval algebra.
+// Missing bounds count as infinities, so two intervals without a start share
+// it. Boundary modes do not apply, as the relations are defined on the
+// endpoints themselves.
+func (e Engine[T]) Relate(r1, r2 Interval[T]) data.Relation {
+	starts := e.CompareStarts(r1.Start, r2.Start)
+	ends := e.CompareEnds(r1.End, r2.End)
+	switch {
+	case starts == 0 && ends == 0:
+		return data.RelationEquals
+	case e.EndBeforeStart(r1.End, r2.Start):
+		return data.RelationBefore
+	case e.EndMeetsStart(r1.End, r2.Start):
+		return data.RelationMeets
+	case e.EndBeforeStart(r2.End, r1.Start):
+		return data.RelationAfter
+	case e.EndMeetsStart(r2.End, r1.Start):
+		return data.RelationMetBy
+	case starts == 0:
+		if ends < 0 {
+			return data.RelationStarts
+		}
+		return data.RelationStartedBy
+	case ends == 0:
+		if starts > 0 {
+			return data.RelationFinishes
+		}
+		return data.RelationFinishedBy
+	case starts > 0 && ends < 0:
+		return data.RelationDuring
+	case starts < 0 && ends > 0:
+		return data.RelationContains
+	case starts < 0:
+		return data.RelationOverlaps
+	default:
+		return data.RelationOverlappedBy
+	}
+}
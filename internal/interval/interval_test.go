@@ -0,0 +1,174 @@
+package interval
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var ints = Ordered[int]()
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func span(start, end int) Interval[int] {
+	return Interval[int]{Start: ptr(start), End: ptr(end)}
+}
+
+// piece is span with the ends it holds.
+func piece(start, end int, b data.Boundary) Piece[int] {
+	return Piece[int]{Interval: span(start, end), Boundary: b}
+}
+
+func TestEngine_Intersection(t *testing.T) {
+	testCases := []struct {
+		name     string
+		r1, r2   Interval[int]
+		boundary data.Boundary
+		expected *Interval[int]
+	}{
+		{"Overlap", span(1, 10), span(5, 20), data.BoundaryClosedOpen, &Interval[int]{Start: ptr(5), End: ptr(10)}},
+		{"Disjoint", span(1, 5), span(6, 10), data.BoundaryClosed, nil},
+		{"Touching Half Open", span(1, 5), span(5, 10), data.BoundaryClosedOpen, nil},
+		{"Touching Closed", span(1, 5), span(5, 10), data.BoundaryClosed, &Interval[int]{Start: ptr(5), End: ptr(5)}},
+		{"Touching Open", span(1, 5), span(5, 10), data.BoundaryOpen, nil},
+		{"No Start", Interval[int]{End: ptr(5)}, span(3, 10), data.BoundaryClosedOpen, &Interval[int]{Start: ptr(3), End: ptr(5)}},
+		{"Both Unbounded", Interval[int]{End: ptr(5)}, Interval[int]{Start: ptr(2)}, data.BoundaryClosedOpen, &Interval[int]{Start: ptr(2), End: ptr(5)}},
+		{"Everything", Interval[int]{}, Interval[int]{}, data.BoundaryOpen, &Interval[int]{}},
+		{"Empty Point", span(5, 5), span(1, 10), data.BoundaryClosedOpen, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, ok := ints.Intersection(tc.r1, tc.r2, tc.boundary)
+			if tc.expected == nil {
+				assert.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			assert.Equal(t, *tc.expected, r)
+		})
+	}
+}
+
+func TestEngine_Relate(t *testing.T) {
+	testCases := []struct {
+		r1, r2   Interval[int]
+		expected data.Relation
+	}{
+		{span(1, 3), span(5, 8), data.RelationBefore},
+		{span(1, 5), span(5, 8), data.RelationMeets},
+		{span(1, 6), span(5, 8), data.RelationOverlaps},
+		{span(5, 6), span(5, 8), data.RelationStarts},
+		{span(6, 7), span(5, 8), data.RelationDuring},
+		{span(6, 8), span(5, 8), data.RelationFinishes},
+		{span(5, 8), span(5, 8), data.RelationEquals},
+		{span(9, 10), span(5, 8), data.RelationAfter},
+		{Interval[int]{End: ptr(8)}, span(5, 8), data.RelationFinishedBy},
+		{Interval[int]{}, Interval[int]{}, data.RelationEquals},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.expected), func(t *testing.T) {
+			assert.Equal(t, tc.expected, ints.Relate(tc.r1, tc.r2))
+		})
+	}
+}
+
+func TestEngine_SetOperations(t *testing.T) {
+	a := []Interval[int]{span(10, 20), span(1, 5), span(4, 8)}
+	b := []Interval[int]{span(6, 12), span(30, 40)}
+	b0 := data.BoundaryClosedOpen
+
+	assert.Equal(t, []Interval[int]{span(1, 8), span(10, 20)}, ints.Normalize(a, b0))
+	assert.Equal(t, []Interval[int]{span(1, 20), span(30, 40)}, ints.Union(a, b, b0))
+	assert.Equal(t, []Interval[int]{span(6, 8), span(10, 12)}, ints.IntersectSets(a, b, b0))
+	assert.Equal(t, []Piece[int]{piece(1, 6, b0), piece(12, 20, b0)}, ints.Difference(a, b, b0))
+	assert.Equal(t, []Piece[int]{piece(1, 6, b0), piece(8, 10, b0), piece(12, 20, b0), piece(30, 40, b0)}, ints.SymmetricDifference(a, b, b0))
+	assert.Equal(t, []Piece[int]{piece(0, 1, b0), piece(8, 10, b0), piece(20, 25, b0)}, ints.Complement(a, span(0, 25), b0))
+	assert.Equal(t, []Piece[int]{{Interval: Interval[int]{End: ptr(1)}, Boundary: b0}, piece(8, 10, b0), {Interval: Interval[int]{Start: ptr(20)}, Boundary: b0}}, ints.Complement(a, Interval[int]{}, b0))
+
+	t.Run("Touching Intervals Stay Apart When Open", func(t *testing.T) {
+		touching := []Interval[int]{span(1, 5), span(5, 9)}
+		assert.Equal(t, []Interval[int]{span(1, 9)}, ints.Normalize(touching, data.BoundaryClosed))
+		assert.Equal(t, touching, ints.Normalize(touching, data.BoundaryOpen))
+	})
+
+	t.Run("Difference Keeps Track Of Ends", func(t *testing.T) {
+		closed, open := data.BoundaryClosed, data.BoundaryOpen
+		assert.Equal(t, []Piece[int]{piece(1, 3, b0), piece(5, 10, data.BoundaryOpenClosed)},
+			ints.Difference([]Interval[int]{span(1, 10)}, []Interval[int]{span(3, 5)}, closed))
+		assert.Equal(t, []Piece[int]{piece(1, 3, data.BoundaryOpenClosed), piece(5, 10, b0)},
+			ints.Difference([]Interval[int]{span(1, 10)}, []Interval[int]{span(3, 5)}, open))
+		assert.Empty(t, ints.Difference([]Interval[int]{span(3, 3)}, []Interval[int]{span(1, 3)}, closed))
+		assert.Equal(t, []Piece[int]{piece(3, 3, closed)},
+			ints.Complement([]Interval[int]{span(1, 3), span(3, 5)}, span(1, 5), open))
+		assert.Equal(t, []Piece[int]{piece(1, 2, data.BoundaryClosedOpen), piece(4, 5, data.BoundaryOpenClosed)},
+			ints.SymmetricDifference([]Interval[int]{span(1, 4)}, []Interval[int]{span(2, 5)}, closed))
+	})
+}
+
+func TestEngine_Overlaps(t *testing.T) {
+	set := []Interval[int]{span(1, 10), span(20, 30), span(5, 25), span(0, 2), {Start: ptr(28)}}
+
+	pairs := ints.Overlaps(set, data.BoundaryClosedOpen)
+
+	assert.Equal(t, []Pair[int]{
+		{First: 0, Second: 3, Intersection: span(1, 2)},
+		{First: 0, Second: 2, Intersection: span(5, 10)},
+		{First: 1, Second: 2, Intersection: span(20, 25)},
+		{First: 1, Second: 4, Intersection: span(28, 30)},
+	}, pairs)
+}
+
+func TestEngine_CustomOrder(t *testing.T) {
+	// Case-insensitive names, the way an alphabetical bracket would be keyed.
+	names := New(func(a, b string) int { return strings.Compare(strings.ToLower(a), strings.ToLower(b)) })
+	r1 := Interval[string]{Start: ptr("apple"), End: ptr("Mango")}
+	r2 := Interval[string]{Start: ptr("KIWI"), End: ptr("zucchini")}
+
+	r, ok := names.Intersection(r1, r2, data.BoundaryClosed)
+	require.True(t, ok)
+	assert.Equal(t, "KIWI", *r.Start)
+	assert.Equal(t, "Mango", *r.End)
+	assert.Equal(t, data.RelationOverlaps, names.Relate(r1, r2))
+}
+
+func TestEngine_Join(t *testing.T) {
+	a := []Interval[int]{span(0, 10), span(20, 30), span(40, 50), span(12, 12)}
+	b := []Interval[int]{span(5, 25), span(8, 9), {Start: ptr(28)}, span(30, 35)}
+
+	type row struct {
+		i, j int
+		r    Interval[int]
+	}
+	var rows []row
+	err := ints.Join(a, b, data.BoundaryClosedOpen, func(i, j int, r Interval[int]) error {
+		rows = append(rows, row{i, j, r})
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []row{
+		{0, 0, span(5, 10)},
+		{0, 1, span(8, 9)},
+		{1, 0, span(20, 25)},
+		{1, 2, span(28, 30)},
+		{2, 2, span(40, 50)},
+	}, rows)
+
+	t.Run("Stops On Error", func(t *testing.T) {
+		calls := 0
+		stop := assert.AnError
+		err := ints.Join(a, b, data.BoundaryClosedOpen, func(int, int, Interval[int]) error {
+			calls++
+			return stop
+		})
+		assert.ErrorIs(t, err, stop)
+		assert.Equal(t, 1, calls)
+	})
+}
@@ -0,0 +1,166 @@
+package interval
+
+import (
+	"sort"
+
+	"github.com/keshu12345/overlap-avalara/data"
+)
+
+// Piece is a member of the result of a difference or complement, with the
+// ends it holds. Taking points out of a set can leave ends that differ from
+// the mode of the operation: with closed intervals, [1,10] without [3,5] is
+// [1,3) and (5,10], and the point left between two open intervals is a closed
+// piece of its own. Missing bounds take the ends of the mode.
+type Piece[T any] struct {
+	Interval[T]
+	Boundary data.Boundary
+}
+
+// cut is a place between the points of the line: just before value, or just
+// after it when after is set.
+type cut[T any] struct {
+	value T
+	after bool
+}
+
+// run is the points from the cut start up to the cut end, nil cuts being the
+// infinities. Every interval, whatever its boundary mode, is a run, and runs
+// only ever meet at a cut, so the difference and complement of runs are exact
+// and can be turned back into pieces.
+type run[T any] struct {
+	start, end *cut[T]
+}
+
+// compareCuts orders two cuts, the one just before a point ahead of the one
+// just after it.
+func (e Engine[T]) compareCuts(a, b cut[T]) int {
+	if c := e.compare(a.value, b.value); c != 0 {
+		return c
+	}
+	switch {
+	case a.after == b.after:
+		return 0
+	case a.after:
+		return 1
+	default:
+		return -1
+	}
+}
+
+// cutBefore reports whether a lies strictly before b. A nil a is a start and a
+// nil b an end, so either one makes it true.
+func (e Engine[T]) cutBefore(a, b *cut[T]) bool {
+	return a == nil || b == nil || e.compareCuts(*a, *b) < 0
+}
+
+// toRuns turns set, read under boundary b, into normalized runs.
+func (e Engine[T]) toRuns(set []Interval[T], b data.Boundary) []run[T] {
+	runs := make([]run[T], len(set))
+	for i, r := range set {
+		if r.Start != nil {
+			runs[i].start = &cut[T]{value: *r.Start, after: !closedStart(b)}
+		}
+		if r.End != nil {
+			runs[i].end = &cut[T]{value: *r.End, after: closedEnd(b)}
+		}
+	}
+	return e.normalizeRuns(runs)
+}
+
+// normalizeRuns drops empty runs, sorts the rest by start and merges the ones
+// that overlap or touch.
+func (e Engine[T]) normalizeRuns(runs []run[T]) []run[T] {
+	sorted := make([]run[T], 0, len(runs))
+	for _, r := range runs {
+		if e.cutBefore(r.start, r.end) {
+			sorted = append(sorted, r)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i].start, sorted[j].start
+		return b != nil && (a == nil || e.compareCuts(*a, *b) < 0)
+	})
+
+	merged := make([]run[T], 0, len(sorted))
+	for _, r := range sorted {
+		n := len(merged)
+		if n > 0 && (merged[n-1].end == nil || r.start == nil || e.compareCuts(*r.start, *merged[n-1].end) <= 0) {
+			if merged[n-1].end != nil && (r.end == nil || e.compareCuts(*r.end, *merged[n-1].end) > 0) {
+				merged[n-1].end = r.end
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// subtractRuns removes the normalized runs b from the normalized runs a.
+func (e Engine[T]) subtractRuns(a, b []run[T]) []run[T] {
+	result := make([]run[T], 0, len(a))
+	j := 0
+	for _, r := range a {
+		for j < len(b) && b[j].end != nil && !e.cutBefore(r.start, b[j].end) {
+			j++
+		}
+
+		// cur is the start of the part of r not yet covered by b.
+		cur, covered := r.start, false
+		for k := j; k < len(b) && e.cutBefore(b[k].start, r.end); k++ {
+			if b[k].start != nil && (cur == nil || e.compareCuts(*cur, *b[k].start) < 0) {
+				result = append(result, run[T]{start: cur, end: b[k].start})
+			}
+			if b[k].end == nil {
+				covered = true
+				break
+			}
+			if cur == nil || e.compareCuts(*cur, *b[k].end) < 0 {
+				cur = b[k].end
+			}
+		}
+		if !covered && e.cutBefore(cur, r.end) {
+			result = append(result, run[T]{start: cur, end: r.end})
+		}
+	}
+	return result
+}
+
+// pieces turns runs back into intervals with the ends they hold.
+func pieces[T any](runs []run[T], b data.Boundary) []Piece[T] {
+	result := make([]Piece[T], len(runs))
+	for i, r := range runs {
+		start, end := closedStart(b), closedEnd(b)
+		if r.start != nil {
+			v := r.start.value
+			result[i].Start, start = &v, !r.start.after
+		}
+		if r.end != nil {
+			v := r.end.value
+			result[i].End, end = &v, r.end.after
+		}
+		result[i].Boundary = boundaryOf(start, end)
+	}
+	return result
+}
+
+func closedStart(b data.Boundary) bool {
+	return b == data.BoundaryClosed || b == data.BoundaryClosedOpen
+}
+
+func closedEnd(b data.Boundary) bool {
+	return b == data.BoundaryClosed || b == data.BoundaryOpenClosed
+}
+
+// boundaryOf names the mode with the given ends.
+func boundaryOf(closedStart, closedEnd bool) data.Boundary {
+	switch {
+	case closedStart && closedEnd:
+		return data.BoundaryClosed
+	case closedStart:
+		return data.BoundaryClosedOpen
+	case closedEnd:
+		return data.BoundaryOpenClosed
+	default:
+		return data.BoundaryOpen
+	}
+}
@@ -0,0 +1,92 @@
+package interval
+
+import (
+	"sort"
+
+	"github.com/keshu12345/overlap-avalara/data"
+)
+
+// Set operations work on normalized sets: empty members are dropped, the rest
+// are sorted and merged. Union and intersection of intervals in one boundary
+// mode stay in that mode. Difference and complement can leave pieces whose
+// ends differ from it, so they return each piece with the ends it holds.
+
+// Normalize drops empty intervals, sorts the rest by start and merges the ones
+// that overlap. Touching intervals are merged too, except in the open mode
+// where the shared endpoint belongs to neither of them.
+func (e Engine[T]) Normalize(set []Interval[T], b data.Boundary) []Interval[T] {
+	sorted := make([]Interval[T], 0, len(set))
+	for _, r := range set {
+		if !e.IsEmpty(r, b) {
+			sorted = append(sorted, r)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return e.CompareStarts(sorted[i].Start, sorted[j].Start) < 0
+	})
+
+	merged := make([]Interval[T], 0, len(sorted))
+	for _, r := range sorted {
+		if n := len(merged); n > 0 && e.joins(merged[n-1], r, b) {
+			merged[n-1].End = e.LaterEnd(merged[n-1].End, r.End)
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// joins reports whether next, which starts no earlier than prev, can be merged into prev.
+func (e Engine[T]) joins(prev, next Interval[T], b data.Boundary) bool {
+	if b == data.BoundaryOpen {
+		return e.StartBeforeEnd(next.Start, prev.End)
+	}
+	return !e.EndBeforeStart(prev.End, next.Start)
+}
+
+// Union merges two sets.
+func (e Engine[T]) Union(a, b []Interval[T], boundary data.Boundary) []Interval[T] {
+	all := make([]Interval[T], 0, len(a)+len(b))
+	return e.Normalize(append(append(all, a...), b...), boundary)
+}
+
+// IntersectSets returns the points covered by both sets.
+func (e Engine[T]) IntersectSets(a, b []Interval[T], boundary data.Boundary) []Interval[T] {
+	return e.intersectNormalized(e.Normalize(a, boundary), e.Normalize(b, boundary), boundary)
+}
+
+// Difference returns the points of a that b does not cover.
+func (e Engine[T]) Difference(a, b []Interval[T], boundary data.Boundary) []Piece[T] {
+	return pieces(e.subtractRuns(e.toRuns(a, boundary), e.toRuns(b, boundary)), boundary)
+}
+
+// SymmetricDifference returns the points covered by exactly one of the sets.
+func (e Engine[T]) SymmetricDifference(a, b []Interval[T], boundary data.Boundary) []Piece[T] {
+	ra, rb := e.toRuns(a, boundary), e.toRuns(b, boundary)
+	return pieces(e.normalizeRuns(append(e.subtractRuns(ra, rb), e.subtractRuns(rb, ra)...)), boundary)
+}
+
+// Complement returns the parts of window that no member of set covers.
+func (e Engine[T]) Complement(set []Interval[T], window Interval[T], boundary data.Boundary) []Piece[T] {
+	return pieces(e.subtractRuns(e.toRuns([]Interval[T]{window}, boundary), e.toRuns(set, boundary)), boundary)
+}
+
+// intersectNormalized walks two normalized sets side by side.
+func (e Engine[T]) intersectNormalized(a, b []Interval[T], boundary data.Boundary) []Interval[T] {
+	result := make([]Interval[T], 0)
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		if r, ok := e.Intersection(a[i], b[j], boundary); ok {
+			result = append(result, r)
+		}
+		switch c := e.CompareEnds(a[i].End, b[j].End); {
+		case c < 0:
+			i++
+		case c > 0:
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+	return e.Normalize(result, boundary)
+}
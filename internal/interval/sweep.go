@@ -0,0 +1,120 @@
+package interval
+
+import (
+	"sort"
+
+	"github.com/keshu12345/overlap-avalara/data"
+)
+
+// Pair is one pair of overlapping intervals. Indexes point into the list that
+// was swept and First is always lower than Second.
+type Pair[T any] struct {
+	First        int
+	Second       int
+	Intersection Interval[T]
+}
+
+// Overlaps reports every overlapping pair in set. Intervals are swept in
+// start order while an active list keeps the ones that have not ended yet, so
+// only intervals that can still overlap are compared. Pairs come in order of
+// their intersection start, then of their indexes.
+func (e Engine[T]) Overlaps(set []Interval[T], b data.Boundary) []Pair[T] {
+	order := e.startOrder(set, b)
+
+	pairs := make([]Pair[T], 0)
+	active := make([]int, 0)
+	for _, cur := range order {
+		kept := active[:0]
+		for _, prev := range active {
+			r, ok := e.Intersection(set[prev], set[cur], b)
+			if !ok {
+				// prev ended before cur starts, and every later interval
+				// starts no earlier than cur, so prev is done.
+				continue
+			}
+			kept = append(kept, prev)
+			first, second := prev, cur
+			if first > second {
+				first, second = second, first
+			}
+			pairs = append(pairs, Pair[T]{First: first, Second: second, Intersection: r})
+		}
+		active = append(kept, cur)
+	}
+
+	sort.SliceStable(pairs, func(i, j int) bool {
+		if c := e.CompareStarts(pairs[i].Intersection.Start, pairs[j].Intersection.Start); c != 0 {
+			return c < 0
+		}
+		if pairs[i].First != pairs[j].First {
+			return pairs[i].First < pairs[j].First
+		}
+		return pairs[i].Second < pairs[j].Second
+	})
+	return pairs
+}
+
+// Join reports every overlapping pair of an interval of a with one of b,
+// calling emit with their indexes and intersection. Both sets are sorted by
+// start and merged, keeping the intervals of each side that have not ended
+// yet, so only pairs that can still overlap are compared. Pairs come in order
+// of their intersection start. Join stops at the first error emit returns.
+func (e Engine[T]) Join(a, b []Interval[T], boundary data.Boundary, emit func(i, j int, r Interval[T]) error) error {
+	orderA, orderB := e.startOrder(a, boundary), e.startOrder(b, boundary)
+	activeA, activeB := make([]int, 0), make([]int, 0)
+	for len(orderA) > 0 || len(orderB) > 0 {
+		fromA := len(orderB) == 0 ||
+			len(orderA) > 0 && e.CompareStarts(a[orderA[0]].Start, b[orderB[0]].Start) <= 0
+		if fromA {
+			cur := orderA[0]
+			orderA = orderA[1:]
+			kept := activeB[:0]
+			for _, other := range activeB {
+				r, ok := e.Intersection(a[cur], b[other], boundary)
+				if !ok {
+					// other ended before cur starts, and every interval still
+					// to come starts no earlier than cur.
+					continue
+				}
+				kept = append(kept, other)
+				if err := emit(cur, other, r); err != nil {
+					return err
+				}
+			}
+			activeB = kept
+			activeA = append(activeA, cur)
+			continue
+		}
+
+		cur := orderB[0]
+		orderB = orderB[1:]
+		kept := activeA[:0]
+		for _, other := range activeA {
+			r, ok := e.Intersection(a[other], b[cur], boundary)
+			if !ok {
+				continue
+			}
+			kept = append(kept, other)
+			if err := emit(other, cur, r); err != nil {
+				return err
+			}
+		}
+		activeA = kept
+		activeB = append(activeB, cur)
+	}
+	return nil
+}
+
+// startOrder returns the indexes of the non-empty intervals of set, sorted by start.
+func (e Engine[T]) startOrder(set []Interval[T], b data.Boundary) []int {
+	order := make([]int, 0, len(set))
+	for i, r := range set {
+		if !e.IsEmpty(r, b) {
+			order = append(order, i)
+		}
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return e.CompareStarts(set[order[i]].Start, set[order[j]].Start) < 0
+	})
+	return order
+}
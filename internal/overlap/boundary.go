@@ -0,0 +1,54 @@
+package overlap
+
+import (
+	"github.com/keshu12345/overlap-avalara/data"
+)
+
+// isEmpty reports whether r contains no instant under boundary b.
+func isEmpty(r data.DateRange, b data.Boundary) bool {
+	return times.IsEmpty(toInterval(r), b)
+}
+
+func hasClosedStart(b data.Boundary) bool {
+	return b == data.BoundaryClosed || b == data.BoundaryClosedOpen
+}
+
+func hasClosedEnd(b data.Boundary) bool {
+	return b == data.BoundaryClosed || b == data.BoundaryOpenClosed
+}
+
+// boundaryOf names the mode with the given ends.
+func boundaryOf(closedStart, closedEnd bool) data.Boundary {
+	switch {
+	case closedStart && closedEnd:
+		return data.BoundaryClosed
+	case closedStart:
+		return data.BoundaryClosedOpen
+	case closedEnd:
+		return data.BoundaryOpenClosed
+	default:
+		return data.BoundaryOpen
+	}
+}
+
+// intersection returns the instants shared by r1 and r2 under boundary b.
+func intersection(r1, r2 data.DateRange, b data.Boundary) (data.DateRange, bool) {
+	r, ok := times.Intersection(toInterval(r1), toInterval(r2), b)
+	return fromInterval(r), ok
+}
+
+// coverage returns the share of r that is covered by an overlap of the given
+// length. A zero-length range that overlaps at all is fully covered, and an
+// unbounded range is only covered by an unbounded overlap.
+func coverage(overlap data.Duration, r data.DateRange) float64 {
+	length := r.Duration()
+	switch {
+	case length <= 0:
+		return 1
+	case length == data.InfiniteDuration && overlap == data.InfiniteDuration:
+		return 1
+	case length == data.InfiniteDuration:
+		return 0
+	}
+	return float64(overlap) / float64(length)
+}
@@ -0,0 +1,190 @@
+package overlap
+
+import (
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/internal/interval"
+)
+
+// times is the interval engine behind DateRange. A zero time.Time is a
+// missing bound: negative infinity when it is a start and positive infinity
+// when it is an end. Every comparison of range bounds goes through these
+// helpers so that open-ended ranges order correctly.
+var times = interval.New(func(a, b time.Time) int { return a.Compare(b) })
+
+func bound(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func instant(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
+
+func toInterval(r data.DateRange) interval.Interval[time.Time] {
+	return interval.Interval[time.Time]{Start: bound(r.Start), End: bound(r.End)}
+}
+
+func fromInterval(i interval.Interval[time.Time]) data.DateRange {
+	return data.DateRange{Start: instant(i.Start), End: instant(i.End)}
+}
+
+func toIntervals(ranges []data.DateRange) []interval.Interval[time.Time] {
+	set := make([]interval.Interval[time.Time], len(ranges))
+	for i, r := range ranges {
+		set[i] = toInterval(r)
+	}
+	return set
+}
+
+func fromIntervals(set []interval.Interval[time.Time]) data.RangeSet {
+	ranges := make(data.RangeSet, len(set))
+	for i, r := range set {
+		ranges[i] = fromInterval(r)
+	}
+	return ranges
+}
+
+// fromPieces turns the result of a difference or complement under boundary b
+// into ranges, marking the pieces whose ends differ from b.
+func fromPieces(set []interval.Piece[time.Time], b data.Boundary) data.RangeSet {
+	ranges := make(data.RangeSet, len(set))
+	for i, p := range set {
+		ranges[i] = fromInterval(p.Interval)
+		if p.Boundary != b {
+			ranges[i].Boundary = p.Boundary
+		}
+	}
+	return ranges
+}
+
+// compareStarts orders two lower bounds.
+func compareStarts(a, b time.Time) int {
+	return times.CompareStarts(bound(a), bound(b))
+}
+
+// compareEnds orders two upper bounds.
+func compareEnds(a, b time.Time) int {
+	return times.CompareEnds(bound(a), bound(b))
+}
+
+// endBeforeStart reports whether upper bound e lies strictly before lower bound s.
+func endBeforeStart(e, s time.Time) bool {
+	return times.EndBeforeStart(bound(e), bound(s))
+}
+
+func earlierStart(a, b time.Time) time.Time {
+	return instant(times.EarlierStart(bound(a), bound(b)))
+}
+
+func laterStart(a, b time.Time) time.Time {
+	return instant(times.LaterStart(bound(a), bound(b)))
+}
+
+func earlierEnd(a, b time.Time) time.Time {
+	return instant(times.EarlierEnd(bound(a), bound(b)))
+}
+
+func laterEnd(a, b time.Time) time.Time {
+	return instant(times.LaterEnd(bound(a), bound(b)))
+}
+
+// edge is a place on the time line between instants: just before at, or just
+// after it when after is set. A zero at is an infinity, negative for a start
+// and positive for an end. A range under any boundary runs from one edge to
+// another, so ranges cut at edges neither overlap nor leave out an instant.
+type edge struct {
+	at    time.Time
+	after bool
+}
+
+// edges returns where r starts and ends, read under its own boundary when it
+// carries one and under b otherwise.
+func edges(r data.DateRange, b data.Boundary) (start, end edge) {
+	if r.Boundary != "" {
+		b = r.Boundary
+	}
+	return edge{at: r.Start, after: r.HasStart() && !hasClosedStart(b)}, edge{at: r.End, after: r.HasEnd() && hasClosedEnd(b)}
+}
+
+// fromEdges returns the range from start to end, marked with its own boundary
+// when its ends differ from b.
+func fromEdges(start, end edge, b data.Boundary) data.DateRange {
+	r := data.DateRange{Start: start.at, End: end.at}
+	closedStart, closedEnd := hasClosedStart(b), hasClosedEnd(b)
+	if r.HasStart() {
+		closedStart = !start.after
+	}
+	if r.HasEnd() {
+		closedEnd = end.after
+	}
+	if ends := boundaryOf(closedStart, closedEnd); ends != b {
+		r.Boundary = ends
+	}
+	return r
+}
+
+// compareEdges orders two finite edges, the one just before an instant ahead
+// of the one just after it.
+func compareEdges(a, b edge) int {
+	if c := a.at.Compare(b.at); c != 0 {
+		return c
+	}
+	switch {
+	case a.after == b.after:
+		return 0
+	case a.after:
+		return 1
+	default:
+		return -1
+	}
+}
+
+// compareStartEdges orders two start edges, a zero one being negative infinity.
+func compareStartEdges(a, b edge) int {
+	switch {
+	case a.at.IsZero() && b.at.IsZero():
+		return 0
+	case a.at.IsZero():
+		return -1
+	case b.at.IsZero():
+		return 1
+	}
+	return compareEdges(a, b)
+}
+
+// compareEndEdges orders two end edges, a zero one being positive infinity.
+func compareEndEdges(a, b edge) int {
+	switch {
+	case a.at.IsZero() && b.at.IsZero():
+		return 0
+	case a.at.IsZero():
+		return 1
+	case b.at.IsZero():
+		return -1
+	}
+	return compareEdges(a, b)
+}
+
+// cutTo cuts r to target, both read as edges under b. ok is false when
+// nothing of r is left.
+func cutTo(r, target data.DateRange, b data.Boundary) (data.DateRange, bool) {
+	start, end := edges(r, b)
+	ts, te := edges(target, b)
+	if compareStartEdges(ts, start) > 0 {
+		start = ts
+	}
+	if compareEndEdges(te, end) < 0 {
+		end = te
+	}
+	if !start.at.IsZero() && !end.at.IsZero() && compareEdges(start, end) >= 0 {
+		return data.DateRange{}, false
+	}
+	return fromEdges(start, end, b), true
+}
@@ -0,0 +1,83 @@
+package overlap
+
+import (
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+)
+
+// BusinessOverlap intersects two ranges and keeps only the parts that fall on
+// working days of the named calendar. Days are read in the calendar's zone,
+// and padding counts towards the overlap like it does everywhere else. The
+// minimum overlap applies to the overlap before non-working days are cut out.
+func (os *overlapService) BusinessOverlap(r1, r2 data.MixedRange, opts data.BusinessDayOptions) (data.BusinessOverlapResult, error) {
+	os.Logger.Info("Computing business day intersection with overlapservice")
+	boundary := os.boundary(opts.OverlapOptions)
+	result := data.BusinessOverlapResult{Intersections: data.RangeSet{}, Calendar: opts.Calendar, Boundary: boundary}
+
+	cal, ok := os.calendars.Get(opts.Calendar)
+	if !ok {
+		return result, &FieldError{Field: "calendar", Message: "unknown calendar " + opts.Calendar}
+	}
+	a, err := instants("range1", r1, cal.Location)
+	if err != nil {
+		return result, err
+	}
+	b, err := instants("range2", r2, cal.Location)
+	if err != nil {
+		return result, err
+	}
+
+	a, b = pad(a, opts.OverlapOptions), pad(b, opts.OverlapOptions)
+	r, ok := intersection(a, b, boundary)
+	if !ok || !longEnough(r.Duration(), a, b, opts.MinOverlap) {
+		return result, nil
+	}
+	if !r.HasStart() || !r.HasEnd() {
+		return result, &FieldError{Field: "range1", Message: "an open-ended overlap has no business-day count, bound range1 or range2"}
+	}
+
+	r = inZone(r, cal.Location)
+	var total time.Duration
+	for day := civilDay(r.Start); ; day = day.AddDate(0, 0, 1) {
+		dayStart := startOfDay(day, cal.Location)
+		if dayStart.After(r.End) || (dayStart.Equal(r.End) && !r.Start.Equal(r.End)) {
+			break
+		}
+		if !cal.IsWorkingDay(data.CivilDate(day)) {
+			continue
+		}
+		piece := data.DateRange{
+			Start: laterStart(r.Start, dayStart),
+			End:   earlierEnd(r.End, startOfDay(day.AddDate(0, 0, 1), cal.Location)),
+		}
+		if piece.End.Before(piece.Start) {
+			continue
+		}
+
+		result.BusinessDays++
+		total += piece.End.Sub(piece.Start)
+		last := len(result.Intersections) - 1
+		if last >= 0 && result.Intersections[last].End.Equal(piece.Start) {
+			result.Intersections[last].End = piece.End
+		} else {
+			result.Intersections = append(result.Intersections, piece)
+		}
+	}
+
+	result.Overlap = result.BusinessDays > 0
+	result.Duration = data.Duration(total)
+	return result, nil
+}
+
+// Calendars lists the names of the known holiday calendars.
+func (os *overlapService) Calendars() data.CalendarList {
+	os.Logger.Info("Listing holiday calendars with overlapservice")
+	return data.CalendarList{Calendars: os.calendars.Names()}
+}
+
+// civilDay returns midnight UTC of the day t falls on in its own location.
+func civilDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
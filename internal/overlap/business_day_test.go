@@ -0,0 +1,132 @@
+package overlap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/internal/calendar"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newCalendarService() OverlapService {
+	newYork, _ := time.LoadLocation("America/New_York")
+	us := calendar.New("US", newYork, []time.Weekday{time.Saturday, time.Sunday}, map[data.CivilDate]string{
+		data.NewCivilDate(2025, time.July, 4): "Independence Day",
+	})
+	utc := calendar.New("UTC", nil, []time.Weekday{time.Saturday, time.Sunday}, nil)
+
+	mockLogger := &MockLogger{}
+	mockLogger.On("Info", mock.Anything).Return()
+	return New(mockLogger, nil, calendar.NewRegistry(us, utc))
+}
+
+func mixed(r data.DateRange) data.MixedRange {
+	return data.MixedRange{Range: &r}
+}
+
+func mixedDays(start, end string) data.MixedRange {
+	r := days(start, end)
+	return data.MixedRange{Dates: &r}
+}
+
+func TestOverlapService_BusinessOverlap(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		range1, range2        data.MixedRange
+		calendar              string
+		expectedDays          int
+		expectedDuration      time.Duration
+		expectedIntersections []string
+	}{
+		{
+			name:             "Holiday Week In Dates",
+			range1:           mixedDays("2025-06-30", "2025-07-06"),
+			range2:           mixedDays("2025-07-01", "2025-07-31"),
+			calendar:         "US",
+			expectedDays:     3,
+			expectedDuration: 72 * time.Hour,
+			expectedIntersections: []string{
+				"2025-07-01T00:00:00-04:00/2025-07-04T00:00:00-04:00",
+			},
+		},
+		{
+			name:             "Timestamps Across A Weekend",
+			range1:           mixed(createDateRange("2025-07-11T16:00:00Z", "2025-07-14T16:00:00Z")),
+			range2:           mixed(createDateRange("2025-07-01T00:00:00Z", "2025-08-01T00:00:00Z")),
+			calendar:         "UTC",
+			expectedDays:     2,
+			expectedDuration: 24 * time.Hour,
+			expectedIntersections: []string{
+				"2025-07-11T16:00:00Z/2025-07-12T00:00:00Z",
+				"2025-07-14T00:00:00Z/2025-07-14T16:00:00Z",
+			},
+		},
+		{
+			name:     "Only On A Holiday",
+			range1:   mixedDays("2025-07-04", "2025-07-04"),
+			range2:   mixedDays("2025-07-01", "2025-07-31"),
+			calendar: "US",
+		},
+		{
+			name:     "No Overlap At All",
+			range1:   mixedDays("2025-07-01", "2025-07-02"),
+			range2:   mixedDays("2025-07-03", "2025-07-31"),
+			calendar: "US",
+		},
+		{
+			name:             "Days Follow The Calendar Zone",
+			range1:           mixed(createDateRange("2025-07-05T02:00:00Z", "2025-07-05T03:00:00Z")),
+			range2:           mixed(from("2025-07-01T00:00:00Z")),
+			calendar:         "US",
+			expectedDays:     0,
+			expectedDuration: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := newCalendarService().BusinessOverlap(tc.range1, tc.range2, data.BusinessDayOptions{Calendar: tc.calendar})
+
+			require.NoError(t, err)
+			intersections := make([]string, 0)
+			for _, r := range result.Intersections {
+				intersections = append(intersections, r.Start.Format(time.RFC3339)+"/"+r.End.Format(time.RFC3339))
+			}
+			assert.Equal(t, tc.expectedDays > 0, result.Overlap)
+			assert.Equal(t, tc.expectedDays, result.BusinessDays)
+			assert.Equal(t, data.Duration(tc.expectedDuration), result.Duration)
+			assert.Equal(t, append([]string{}, tc.expectedIntersections...), intersections)
+			assert.Equal(t, tc.calendar, result.Calendar)
+		})
+	}
+}
+
+func TestOverlapService_BusinessOverlapErrors(t *testing.T) {
+	testCases := []struct {
+		name          string
+		range1        data.MixedRange
+		calendar      string
+		expectedField string
+	}{
+		{name: "Unknown Calendar", range1: mixedDays("2025-07-01", "2025-07-31"), calendar: "XX", expectedField: "calendar"},
+		{name: "Open-Ended Overlap", range1: mixed(from("2025-07-01T00:00:00Z")), calendar: "US", expectedField: "range1"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := newCalendarService().BusinessOverlap(tc.range1, mixed(from("2025-01-01T00:00:00Z")), data.BusinessDayOptions{Calendar: tc.calendar})
+
+			var fieldErr *FieldError
+			require.ErrorAs(t, err, &fieldErr)
+			assert.Equal(t, tc.expectedField, fieldErr.Field)
+		})
+	}
+}
+
+func TestOverlapService_Calendars(t *testing.T) {
+	assert.Equal(t, []string{"US", "UTC"}, newCalendarService().Calendars().Calendars)
+	assert.Equal(t, []string{}, newTestService().Calendars().Calendars)
+}
@@ -0,0 +1,87 @@
+package overlap
+
+import (
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/internal/zone"
+)
+
+// IntersectDates returns the days two civil date ranges share. Days are whole
+// and both ends are inclusive, so boundary modes do not apply: ranges that
+// end and start on the same day share that day.
+func (os *overlapService) IntersectDates(r1, r2 data.CivilDateRange) data.CivilOverlapResult {
+	os.Logger.Info("Computing civil date range intersection with overlapservice")
+	result := data.CivilOverlapResult{Range1Days: r1.Days(), Range2Days: r2.Days()}
+
+	r, ok := intersection(midnights(r1), midnights(r2), data.BoundaryClosed)
+	if !ok {
+		return result
+	}
+	days := data.CivilDateRange{Start: data.CivilDate(r.Start), End: data.CivilDate(r.End)}
+	result.Overlap = true
+	result.Intersection = &days
+	result.Days = days.Days()
+	return result
+}
+
+// IntersectMixed intersects two ranges that may each be a timestamp range or a
+// civil date range. Civil dates become instants under opts.Zone, a day running
+// from its midnight there to the next, and the result is given in that zone.
+func (os *overlapService) IntersectMixed(r1, r2 data.MixedRange, opts data.MixedOptions) (data.ZonedOverlapResult, error) {
+	os.Logger.Info("Computing mixed time range intersection with overlapservice")
+
+	loc, err := zone.Load(opts.Zone)
+	if err != nil {
+		return data.ZonedOverlapResult{}, &FieldError{Field: "zone", Message: err.Error()}
+	}
+	a, err := instants("range1", r1, loc)
+	if err != nil {
+		return data.ZonedOverlapResult{}, err
+	}
+	b, err := instants("range2", r2, loc)
+	if err != nil {
+		return data.ZonedOverlapResult{}, err
+	}
+
+	result := intersectPadded(a, b, opts.OverlapOptions, os.boundary(opts.OverlapOptions))
+	return data.ZonedOverlapResult{
+		OverlapResult: resultInZone(result, loc),
+		Range1:        inZone(a, loc),
+		Range2:        inZone(b, loc),
+		OutputZone:    loc.String(),
+	}, nil
+}
+
+// midnights maps a civil date range onto a closed range of UTC midnights, one
+// per day, which keeps day arithmetic free of zones.
+func midnights(r data.CivilDateRange) data.DateRange {
+	return data.DateRange{Start: r.Start.Midnight(), End: r.End.Midnight()}
+}
+
+// instants resolves a mixed range in loc. A civil range runs from the start of
+// its first day to the start of the day after its last, so it is half-open.
+func instants(name string, r data.MixedRange, loc *time.Location) (data.DateRange, error) {
+	if r.Dates == nil {
+		if r.Range == nil {
+			return data.DateRange{}, &FieldError{Field: name, Message: "set exactly one of range or dates"}
+		}
+		return *r.Range, nil
+	}
+
+	var resolved data.DateRange
+	if !r.Dates.Start.IsZero() {
+		resolved.Start = startOfDay(r.Dates.Start.Midnight(), loc)
+	}
+	if !r.Dates.End.IsZero() {
+		resolved.End = startOfDay(r.Dates.End.Midnight().AddDate(0, 0, 1), loc)
+	}
+	return resolved, nil
+}
+
+// startOfDay returns the first instant of the day whose midnight UTC is day.
+// A few zones skip midnight when clocks go forward, in which case the day
+// starts when the clocks resume.
+func startOfDay(day time.Time, loc *time.Location) time.Time {
+	return wallClock(day, loc)
+}
@@ -0,0 +1,154 @@
+package overlap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func civil(s string) data.CivilDate {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return data.CivilDate(t)
+}
+
+func days(start, end string) data.CivilDateRange {
+	return data.CivilDateRange{Start: civil(start), End: civil(end)}
+}
+
+func intPtr(n int) *int {
+	return &n
+}
+
+func TestOverlapService_IntersectDates(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		range1, range2       data.CivilDateRange
+		expectedIntersection *data.CivilDateRange
+		expectedDays         *int
+	}{
+		{
+			name:                 "Overlapping Quarters And Months",
+			range1:               days("2025-01-01", "2025-03-31"),
+			range2:               days("2025-03-01", "2025-04-30"),
+			expectedIntersection: &data.CivilDateRange{Start: civil("2025-03-01"), End: civil("2025-03-31")},
+			expectedDays:         intPtr(31),
+		},
+		{
+			name:                 "Sharing The Last Day",
+			range1:               days("2025-01-01", "2025-01-31"),
+			range2:               days("2025-01-31", "2025-02-28"),
+			expectedIntersection: &data.CivilDateRange{Start: civil("2025-01-31"), End: civil("2025-01-31")},
+			expectedDays:         intPtr(1),
+		},
+		{
+			name:   "Consecutive Months",
+			range1: days("2025-01-01", "2025-01-31"),
+			range2: days("2025-02-01", "2025-02-28"),
+		},
+		{
+			name:                 "Leap Day",
+			range1:               days("2024-02-01", "2024-02-29"),
+			range2:               days("2024-02-29", "2024-03-31"),
+			expectedIntersection: &data.CivilDateRange{Start: civil("2024-02-29"), End: civil("2024-02-29")},
+			expectedDays:         intPtr(1),
+		},
+		{
+			name:                 "Open-Ended Certificate",
+			range1:               data.CivilDateRange{Start: civil("2025-01-01")},
+			range2:               data.CivilDateRange{End: civil("2025-06-30")},
+			expectedIntersection: &data.CivilDateRange{Start: civil("2025-01-01"), End: civil("2025-06-30")},
+			expectedDays:         intPtr(181),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := newTestService().IntersectDates(tc.range1, tc.range2)
+
+			assert.Equal(t, tc.expectedIntersection != nil, result.Overlap)
+			assert.Equal(t, tc.expectedIntersection, result.Intersection)
+			assert.Equal(t, tc.expectedDays, result.Days)
+			assert.Equal(t, tc.range1.Days(), result.Range1Days)
+			assert.Equal(t, tc.range2.Days(), result.Range2Days)
+		})
+	}
+}
+
+func TestOverlapService_IntersectMixed(t *testing.T) {
+	january := days("2025-01-01", "2025-01-31")
+
+	testCases := []struct {
+		name            string
+		range1, range2  data.MixedRange
+		zone            string
+		expectedOverlap bool
+		expectedRange1  string
+	}{
+		{
+			name:            "Last Evening Of The Month In New York",
+			range1:          data.MixedRange{Dates: &january},
+			range2:          data.MixedRange{Range: rangePtr(createDateRange("2025-02-01T03:00:00Z", "2025-02-01T04:00:00Z"))},
+			zone:            "America/New_York",
+			expectedOverlap: true,
+			expectedRange1:  "2025-01-01T00:00:00-05:00/2025-02-01T00:00:00-05:00",
+		},
+		{
+			name:           "Same Instant Is February In UTC",
+			range1:         data.MixedRange{Dates: &january},
+			range2:         data.MixedRange{Range: rangePtr(createDateRange("2025-02-01T03:00:00Z", "2025-02-01T04:00:00Z"))},
+			zone:           "UTC",
+			expectedRange1: "2025-01-01T00:00:00Z/2025-02-01T00:00:00Z",
+		},
+		{
+			name:           "Two Date Ranges",
+			range1:         data.MixedRange{Dates: &january},
+			range2:         data.MixedRange{Dates: rangeDaysPtr(days("2025-02-01", "2025-02-28"))},
+			zone:           "Asia/Tokyo",
+			expectedRange1: "2025-01-01T00:00:00+09:00/2025-02-01T00:00:00+09:00",
+		},
+		{
+			name:            "Day Without A Midnight",
+			range1:          data.MixedRange{Dates: rangeDaysPtr(days("2025-09-07", "2025-09-07"))},
+			range2:          data.MixedRange{Range: rangePtr(createDateRange("2025-09-07T00:00:00Z", "2025-09-08T00:00:00Z"))},
+			zone:            "America/Santiago",
+			expectedOverlap: true,
+			expectedRange1:  "2025-09-07T01:00:00-03:00/2025-09-08T00:00:00-03:00",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := newTestService().IntersectMixed(tc.range1, tc.range2, data.MixedOptions{Zone: tc.zone})
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedOverlap, result.Overlap)
+			assert.Equal(t, tc.zone, result.OutputZone)
+			assert.Equal(t, tc.expectedRange1,
+				result.Range1.Start.Format(time.RFC3339)+"/"+result.Range1.End.Format(time.RFC3339))
+		})
+	}
+}
+
+func TestOverlapService_IntersectMixedUnknownZone(t *testing.T) {
+	january := days("2025-01-01", "2025-01-31")
+
+	_, err := newTestService().IntersectMixed(data.MixedRange{Dates: &january}, data.MixedRange{Dates: &january}, data.MixedOptions{Zone: "Nowhere"})
+
+	var fieldErr *FieldError
+	require.ErrorAs(t, err, &fieldErr)
+	assert.Equal(t, "zone", fieldErr.Field)
+}
+
+func rangePtr(r data.DateRange) *data.DateRange {
+	return &r
+}
+
+func rangeDaysPtr(r data.CivilDateRange) *data.CivilDateRange {
+	return &r
+}
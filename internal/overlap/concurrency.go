@@ -0,0 +1,210 @@
+package overlap
+
+import (
+	"sort"
+
+	"github.com/keshu12345/overlap-avalara/data"
+)
+
+// Concurrency reports the peak of the occupancy timeline of ranges. Padding
+// applies; the minimum overlap does not.
+func (os *overlapService) Concurrency(ranges []data.IdentifiedRange, opts data.ConcurrencyOptions) data.ConcurrencyResult {
+	os.Logger.Info("Computing range concurrency with overlapservice")
+	boundary := os.boundary(opts.OverlapOptions)
+
+	padded := padEach(ranges, opts.OverlapOptions)
+	segments := occupancyTimeline(padded, boundary)
+	if opts.Timeline {
+		withIndexes(padded, segments, boundary)
+	}
+	result := data.ConcurrencyResult{PeakSegments: []data.OccupancySegment{}, Boundary: boundary}
+	for _, s := range segments {
+		if s.Count > result.Peak {
+			result.Peak = s.Count
+			result.PeakSegments = result.PeakSegments[:0]
+		}
+		if s.Count == result.Peak && s.Count > 0 {
+			result.PeakSegments = append(result.PeakSegments, s)
+		}
+	}
+	if opts.Timeline {
+		result.Timeline = segments
+	} else {
+		withIndexes(padded, result.PeakSegments, boundary)
+	}
+	return result
+}
+
+// occupancyTimeline sweeps the start and end edges of the ranges in order and
+// counts the ranges active in between. The timeline runs from the first start
+// to the last end. Segments run from one edge to the next, so under [] the
+// instant where touching ranges meet is a segment of its own and the
+// segments either side of it leave it out, and under () that instant is an
+// idle segment; segments whose ends differ from the boundary carry their own.
+// Callers that look for conflicts pad the ranges first. Only the counts are
+// kept: listing the active ranges of every segment takes quadratic time for
+// nested ranges, so callers fill in the indexes of the segments they return
+// with withIndexes.
+func occupancyTimeline(ranges []data.IdentifiedRange, boundary data.Boundary) []data.OccupancySegment {
+	type event struct {
+		edge
+		start bool
+	}
+	count := 0
+	events := make([]event, 0, 2*len(ranges))
+	for _, r := range ranges {
+		if isEmpty(r.Range, boundary) {
+			continue
+		}
+		start, end := edges(r.Range, boundary)
+		if r.Range.HasStart() {
+			events = append(events, event{edge: start, start: true})
+		} else {
+			count++
+		}
+		if r.Range.HasEnd() {
+			events = append(events, event{edge: end})
+		}
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return compareEdges(events[i].edge, events[j].edge) < 0
+	})
+
+	// Every edge starts or ends a range, so the ranges active change at each.
+	timeline := &occupancy{boundary: boundary}
+	var prev edge
+	for i := 0; i < len(events); {
+		at := events[i].edge
+		timeline.add(prev, at, count)
+		for ; i < len(events) && compareEdges(events[i].edge, at) == 0; i++ {
+			if events[i].start {
+				count++
+			} else {
+				count--
+			}
+		}
+		prev = at
+	}
+	timeline.add(prev, edge{}, count)
+	return timeline.trimmed()
+}
+
+// occupancy collects the segments of a timeline.
+type occupancy struct {
+	boundary data.Boundary
+	segments []data.OccupancySegment
+}
+
+// add appends the segment from start to end with count ranges active.
+func (o *occupancy) add(start, end edge, count int) {
+	o.segments = append(o.segments, data.OccupancySegment{Range: fromEdges(start, end, o.boundary), Count: count})
+}
+
+// trimmed drops the idle segments before the first start and after the last end.
+func (o *occupancy) trimmed() []data.OccupancySegment {
+	segments := o.segments
+	for len(segments) > 0 && segments[0].Count == 0 {
+		segments = segments[1:]
+	}
+	for len(segments) > 0 && segments[len(segments)-1].Count == 0 {
+		segments = segments[:len(segments)-1]
+	}
+	return segments
+}
+
+// withIndexes fills in the indexes and IDs of the ranges active in segments,
+// which are taken from the timeline of ranges and kept in time order. A range
+// is active in a segment when it covers all of it; the sweep keeps those that
+// started by the start of the segment and have not ended before its end, so
+// the work done is that of the lists built.
+func withIndexes(ranges []data.IdentifiedRange, segments []data.OccupancySegment, boundary data.Boundary) {
+	sweep := newCoverSweep(ranges, boundary)
+	active := make(map[int]bool)
+	for k := range segments {
+		s := &segments[k]
+		sweep.advance(s.Range, func(i int) { active[i] = true }, func(i int) { delete(active, i) })
+
+		s.Indexes = make([]int, 0, len(active))
+		for i := range active {
+			s.Indexes = append(s.Indexes, i)
+		}
+		sort.Ints(s.Indexes)
+		s.IDs = nil
+		for _, i := range s.Indexes {
+			if id := ranges[i].ID; id != "" {
+				s.IDs = append(s.IDs, id)
+			}
+		}
+	}
+}
+
+// coverSweep walks the ranges covering a series of segments in time order.
+// Ranges and segments are compared by their edges under boundary.
+type coverSweep struct {
+	boundary       data.Boundary
+	starts, ends   []edge
+	byStart, byEnd []int
+	started, ended int
+	gone           []bool
+}
+
+func newCoverSweep(ranges []data.IdentifiedRange, boundary data.Boundary) *coverSweep {
+	s := &coverSweep{
+		boundary: boundary,
+		starts:   make([]edge, len(ranges)),
+		ends:     make([]edge, len(ranges)),
+		gone:     make([]bool, len(ranges)),
+	}
+	for i, r := range ranges {
+		s.starts[i], s.ends[i] = edges(r.Range, boundary)
+		if !isEmpty(r.Range, boundary) {
+			s.byStart = append(s.byStart, i)
+		}
+	}
+	s.byEnd = append([]int(nil), s.byStart...)
+	sort.SliceStable(s.byStart, func(i, j int) bool {
+		return compareStartEdges(s.starts[s.byStart[i]], s.starts[s.byStart[j]]) < 0
+	})
+	sort.SliceStable(s.byEnd, func(i, j int) bool {
+		return compareEndEdges(s.ends[s.byEnd[i]], s.ends[s.byEnd[j]]) < 0
+	})
+	return s
+}
+
+// advance moves the sweep on to segment, calling leave for the ranges that
+// end before it does and enter for those that start by its start and still
+// cover it. Each range enters and leaves at most once.
+func (s *coverSweep) advance(segment data.DateRange, enter, leave func(int)) {
+	start, end := edges(segment, s.boundary)
+	for ; s.ended < len(s.byEnd); s.ended++ {
+		i := s.byEnd[s.ended]
+		if compareEndEdges(s.ends[i], end) >= 0 {
+			break
+		}
+		if !s.gone[i] {
+			s.gone[i] = true
+			leave(i)
+		}
+	}
+	for ; s.started < len(s.byStart); s.started++ {
+		i := s.byStart[s.started]
+		if compareStartEdges(s.starts[i], start) > 0 {
+			break
+		}
+		if !s.gone[i] {
+			enter(i)
+		}
+	}
+}
+
+func sameIndexes(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
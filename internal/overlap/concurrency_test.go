@@ -0,0 +1,180 @@
+package overlap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func segment(r data.DateRange, indexes []int, ids ...string) data.OccupancySegment {
+	return data.OccupancySegment{Range: r, Count: len(indexes), Indexes: indexes, IDs: ids}
+}
+
+func TestOverlapService_Concurrency(t *testing.T) {
+	service := newTestService()
+	ranges := []data.IdentifiedRange{
+		{ID: "a", Range: hours(8, 12)},
+		{ID: "b", Range: hours(9, 11)},
+		{Range: hours(10, 13)},
+		{ID: "d", Range: hours(14, 15)},
+	}
+
+	result := service.Concurrency(ranges, data.ConcurrencyOptions{Timeline: true})
+
+	assert.Equal(t, 3, result.Peak)
+	assert.Equal(t, []data.OccupancySegment{segment(hours(10, 11), []int{0, 1, 2}, "a", "b")}, result.PeakSegments)
+	assert.Equal(t, []data.OccupancySegment{
+		segment(hours(8, 9), []int{0}, "a"),
+		segment(hours(9, 10), []int{0, 1}, "a", "b"),
+		segment(hours(10, 11), []int{0, 1, 2}, "a", "b"),
+		segment(hours(11, 12), []int{0, 2}, "a"),
+		segment(hours(12, 13), []int{2}),
+		segment(hours(13, 14), []int{}),
+		segment(hours(14, 15), []int{3}, "d"),
+	}, result.Timeline)
+	assert.Equal(t, data.BoundaryClosedOpen, result.Boundary)
+}
+
+func TestOverlapService_ConcurrencyPeakOnly(t *testing.T) {
+	service := newTestService()
+	ranges := []data.IdentifiedRange{
+		{ID: "a", Range: hours(8, 9)},
+		{ID: "b", Range: hours(8, 9)},
+		{ID: "c", Range: hours(10, 11)},
+		{ID: "d", Range: hours(10, 11)},
+	}
+
+	result := service.Concurrency(ranges, data.ConcurrencyOptions{})
+
+	assert.Equal(t, 2, result.Peak)
+	assert.Equal(t, []data.OccupancySegment{
+		segment(hours(8, 9), []int{0, 1}, "a", "b"),
+		segment(hours(10, 11), []int{2, 3}, "c", "d"),
+	}, result.PeakSegments)
+	assert.Nil(t, result.Timeline)
+}
+
+func TestOverlapService_ConcurrencyBoundaryModes(t *testing.T) {
+	touching := []data.IdentifiedRange{{ID: "a", Range: hours(8, 9)}, {ID: "b", Range: hours(9, 10)}}
+	nine := mustParseTime("2025-07-01T09:00:00Z")
+
+	testCases := []struct {
+		boundary data.Boundary
+		peak     int
+		segments []data.OccupancySegment
+	}{
+		{data.BoundaryClosedOpen, 1, []data.OccupancySegment{segment(hours(8, 9), []int{0}, "a"), segment(hours(9, 10), []int{1}, "b")}},
+		{data.BoundaryOpen, 1, []data.OccupancySegment{segment(hours(8, 9), []int{0}, "a"), segment(hours(9, 10), []int{1}, "b")}},
+		{data.BoundaryClosed, 2, []data.OccupancySegment{segment(data.DateRange{Start: nine, End: nine}, []int{0, 1}, "a", "b")}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.boundary), func(t *testing.T) {
+			service := newTestService()
+			result := service.Concurrency(touching, data.ConcurrencyOptions{OverlapOptions: data.OverlapOptions{Boundary: tc.boundary}})
+
+			assert.Equal(t, tc.peak, result.Peak)
+			assert.Equal(t, tc.segments, result.PeakSegments)
+
+			pairs := service.FindOverlaps(touching, data.OverlapOptions{Boundary: tc.boundary})
+			assert.Equal(t, tc.peak > 1, len(pairs.Pairs) > 0, "peak must agree with FindOverlaps")
+		})
+	}
+}
+
+func TestOverlapService_ConcurrencyClosedTimeline(t *testing.T) {
+	service := newTestService()
+	ranges := []data.IdentifiedRange{
+		{ID: "a", Range: hours(1, 5)},
+		{ID: "b", Range: hours(5, 10)},
+		{ID: "c", Range: hours(2, 8)},
+	}
+
+	result := service.Concurrency(ranges, data.ConcurrencyOptions{Timeline: true, OverlapOptions: data.OverlapOptions{Boundary: data.BoundaryClosed}})
+
+	assert.Equal(t, 3, result.Peak)
+	assert.Equal(t, []data.OccupancySegment{segment(hours(5, 5), []int{0, 1, 2}, "a", "b", "c")}, result.PeakSegments)
+	assert.Equal(t, []data.OccupancySegment{
+		segment(bounded(hours(1, 2), data.BoundaryClosedOpen), []int{0}, "a"),
+		segment(bounded(hours(2, 5), data.BoundaryClosedOpen), []int{0, 2}, "a", "c"),
+		segment(hours(5, 5), []int{0, 1, 2}, "a", "b", "c"),
+		segment(bounded(hours(5, 8), data.BoundaryOpenClosed), []int{1, 2}, "b", "c"),
+		segment(bounded(hours(8, 10), data.BoundaryOpenClosed), []int{1}, "b"),
+	}, result.Timeline, "each segment's count holds at every instant it claims")
+}
+
+func TestOverlapService_ConcurrencyOpenTimeline(t *testing.T) {
+	service := newTestService()
+	ranges := []data.IdentifiedRange{{ID: "a", Range: hours(8, 9)}, {ID: "b", Range: hours(9, 10)}}
+
+	result := service.Concurrency(ranges, data.ConcurrencyOptions{Timeline: true, OverlapOptions: data.OverlapOptions{Boundary: data.BoundaryOpen}})
+
+	assert.Equal(t, []data.OccupancySegment{
+		segment(hours(8, 9), []int{0}, "a"),
+		segment(bounded(hours(9, 9), data.BoundaryClosed), []int{}),
+		segment(hours(9, 10), []int{1}, "b"),
+	}, result.Timeline, "the instant neither open range holds is idle")
+}
+
+func TestOverlapService_ConcurrencyOpenEndedAndPadded(t *testing.T) {
+	service := newTestService()
+	ranges := []data.IdentifiedRange{
+		{ID: "forever", Range: from("2025-07-01T09:00:00Z")},
+		{ID: "a", Range: hours(8, 10)},
+		{ID: "b", Range: hours(10, 11)},
+	}
+
+	result := service.Concurrency(ranges, data.ConcurrencyOptions{
+		Timeline:       true,
+		OverlapOptions: data.OverlapOptions{Padding: padding(0, 30*time.Minute)},
+	})
+
+	assert.Equal(t, 3, result.Peak)
+	require.Len(t, result.PeakSegments, 1)
+	assert.Equal(t, createDateRange("2025-07-01T10:00:00Z", "2025-07-01T10:30:00Z"), result.PeakSegments[0].Range)
+
+	last := result.Timeline[len(result.Timeline)-1]
+	assert.Equal(t, from("2025-07-01T11:30:00Z"), last.Range, "the open-ended range keeps the timeline open")
+	assert.Equal(t, []string{"forever"}, last.IDs)
+}
+
+func TestOverlapService_ConcurrencyEmpty(t *testing.T) {
+	service := newTestService()
+
+	result := service.Concurrency([]data.IdentifiedRange{{Range: hours(9, 9)}}, data.ConcurrencyOptions{Timeline: true})
+
+	assert.Equal(t, 0, result.Peak)
+	assert.Empty(t, result.PeakSegments)
+	assert.Empty(t, result.Timeline)
+}
+
+func TestOverlapService_ConcurrencyManyNestedRanges(t *testing.T) {
+	service := newTestService()
+	noon := mustParseTime("2025-07-01T12:00:00Z")
+	const n = 6000
+	ranges := make([]data.IdentifiedRange, n)
+	records := make([]data.PartitionRecord, n)
+	for i := range ranges {
+		r := data.DateRange{Start: noon.Add(-time.Duration(i+1) * time.Minute), End: noon.Add(time.Duration(i+1) * time.Minute)}
+		ranges[i] = data.IdentifiedRange{Range: r}
+		records[i] = data.PartitionRecord{Range: r}
+	}
+
+	result := service.Concurrency(ranges, data.ConcurrencyOptions{})
+	assert.Equal(t, n, result.Peak)
+	require.Len(t, result.PeakSegments, 1)
+	assert.Len(t, result.PeakSegments[0].Indexes, n)
+	assert.Equal(t, data.DateRange{Start: noon.Add(-time.Minute), End: noon.Add(time.Minute)}, result.PeakSegments[0].Range)
+
+	target := data.DateRange{Start: noon.Add(-time.Minute), End: noon.Add(time.Minute)}
+	coverage := service.Coverage(target, ranges, data.OverlapOptions{})
+	require.Len(t, coverage.Duplicated, 1)
+	assert.Equal(t, n, coverage.Duplicated[0].Count)
+
+	partition := service.Partition(records, data.PartitionOptions{Policy: data.PartitionLatestStart})
+	require.Len(t, partition.Segments, 2*n-1)
+	assert.Equal(t, []int{0}, partition.Segments[n-1].Indexes, "the innermost range starts last")
+}
@@ -0,0 +1,40 @@
+package overlap
+
+import (
+	"github.com/keshu12345/overlap-avalara/data"
+)
+
+// Coverage checks that ranges cover target without gaps. The gaps are the
+// complement of the ranges within target, and the duplicated parts the
+// segments of the occupancy timeline with more than one range active, cut to
+// target. Padding only widens the ranges when looking for duplicated parts;
+// the gaps are those the ranges as given leave.
+func (os *overlapService) Coverage(target data.DateRange, ranges []data.IdentifiedRange, opts data.OverlapOptions) data.CoverageResult {
+	os.Logger.Info("Checking range coverage with overlapservice")
+	boundary := os.boundary(opts)
+
+	members := make([]data.DateRange, len(ranges))
+	for i, r := range ranges {
+		members[i] = r.Range
+	}
+	gaps := complement(members, target, boundary)
+
+	padded := padEach(ranges, opts)
+	duplicated := make([]data.OccupancySegment, 0)
+	for _, s := range occupancyTimeline(padded, boundary) {
+		if _, ok := cutTo(s.Range, target, boundary); ok && s.Count >= 2 {
+			duplicated = append(duplicated, s)
+		}
+	}
+	withIndexes(padded, duplicated, boundary)
+	for i := range duplicated {
+		duplicated[i].Range, _ = cutTo(duplicated[i].Range, target, boundary)
+	}
+
+	return data.CoverageResult{
+		Complete:   len(gaps) == 0,
+		Gaps:       gaps,
+		Duplicated: duplicated,
+		Boundary:   boundary,
+	}
+}
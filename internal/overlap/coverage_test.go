@@ -0,0 +1,92 @@
+package overlap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOverlapService_Coverage(t *testing.T) {
+	target := hours(8, 16)
+
+	testCases := []struct {
+		name       string
+		ranges     []data.IdentifiedRange
+		opts       data.OverlapOptions
+		complete   bool
+		gaps       data.RangeSet
+		duplicated []data.OccupancySegment
+	}{
+		{
+			name:       "Continuous Rows",
+			ranges:     []data.IdentifiedRange{{ID: "a", Range: hours(8, 12)}, {ID: "b", Range: hours(12, 16)}},
+			complete:   true,
+			gaps:       data.RangeSet{},
+			duplicated: []data.OccupancySegment{},
+		},
+		{
+			name:       "Rows Spill Over The Target",
+			ranges:     []data.IdentifiedRange{{ID: "a", Range: hours(0, 12)}, {ID: "b", Range: from("2025-07-01T12:00:00Z")}},
+			complete:   true,
+			gaps:       data.RangeSet{},
+			duplicated: []data.OccupancySegment{},
+		},
+		{
+			name:       "Gaps At Both Ends And Inside",
+			ranges:     []data.IdentifiedRange{{ID: "a", Range: hours(9, 11)}, {ID: "b", Range: hours(12, 15)}},
+			gaps:       data.RangeSet{hours(8, 9), hours(11, 12), hours(15, 16)},
+			duplicated: []data.OccupancySegment{},
+		},
+		{
+			name:     "Overlapping Rows Are Duplicated Coverage",
+			ranges:   []data.IdentifiedRange{{ID: "a", Range: hours(6, 12)}, {ID: "b", Range: hours(11, 16)}, {Range: hours(7, 9)}},
+			complete: true,
+			gaps:     data.RangeSet{},
+			duplicated: []data.OccupancySegment{
+				segment(hours(8, 9), []int{0, 2}, "a"),
+				segment(hours(11, 12), []int{0, 1}, "a", "b"),
+			},
+		},
+		{
+			name:       "Padding Does Not Close A Gap",
+			ranges:     []data.IdentifiedRange{{ID: "a", Range: hours(8, 12)}, {ID: "b", Range: createDateRange("2025-07-01T12:10:00Z", "2025-07-01T16:00:00Z")}},
+			opts:       data.OverlapOptions{Padding: padding(0, 10*time.Minute)},
+			complete:   false,
+			gaps:       data.RangeSet{createDateRange("2025-07-01T12:00:00Z", "2025-07-01T12:10:00Z")},
+			duplicated: []data.OccupancySegment{},
+		},
+		{
+			name:       "No Rows",
+			gaps:       data.RangeSet{target},
+			duplicated: []data.OccupancySegment{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := newTestService()
+
+			result := service.Coverage(target, tc.ranges, tc.opts)
+
+			assert.Equal(t, tc.complete, result.Complete)
+			assert.Equal(t, tc.gaps, result.Gaps)
+			assert.Equal(t, tc.duplicated, result.Duplicated)
+			assert.Equal(t, data.BoundaryClosedOpen, result.Boundary)
+		})
+	}
+}
+
+func TestOverlapService_CoverageClosedBoundary(t *testing.T) {
+	service := newTestService()
+	ranges := []data.IdentifiedRange{{ID: "a", Range: hours(8, 12)}, {ID: "b", Range: hours(12, 16)}}
+	noon := mustParseTime("2025-07-01T12:00:00Z")
+
+	result := service.Coverage(hours(8, 16), ranges, data.OverlapOptions{Boundary: data.BoundaryClosed})
+
+	assert.True(t, result.Complete)
+	assert.Equal(t, []data.OccupancySegment{
+		segment(data.DateRange{Start: noon, End: noon}, []int{0, 1}, "a", "b"),
+	}, result.Duplicated, "closed rows that touch both cover the shared instant")
+}
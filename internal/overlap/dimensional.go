@@ -0,0 +1,206 @@
+package overlap
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/internal/interval"
+)
+
+// FindDimensionalOverlaps reports the pairs of records that intersect in time
+// and in every dimension. Records are first bucketed by the values of their
+// most varied discrete dimension, so only records sharing a value, or leaving
+// the dimension out, are swept against each other in time. The remaining
+// dimensions are checked on the pairs the sweep finds.
+func (os *overlapService) FindDimensionalOverlaps(records []data.DimensionalRecord, opts data.OverlapOptions) (data.DimensionalOverlapResult, error) {
+	os.Logger.Info("Finding multi-dimensional conflicts with overlapservice")
+	boundary := os.boundary(opts)
+	if err := checkDimensionKinds(records); err != nil {
+		return data.DimensionalOverlapResult{}, err
+	}
+
+	padded := make([]data.DateRange, len(records))
+	for i, r := range records {
+		padded[i] = pad(r.Range, opts)
+	}
+
+	conflicts := make([]data.DimensionalConflict, 0)
+	seen := make(map[[2]int]bool)
+	for _, bucket := range dimensionBuckets(records) {
+		set := make([]interval.Interval[time.Time], len(bucket))
+		for i, idx := range bucket {
+			set[i] = toInterval(padded[idx])
+		}
+		for _, p := range times.Overlaps(set, boundary) {
+			i, j := bucket[p.First], bucket[p.Second]
+			if seen[[2]int{i, j}] {
+				continue
+			}
+			seen[[2]int{i, j}] = true
+
+			r := fromInterval(p.Intersection)
+			if !longEnough(r.Duration(), padded[i], padded[j], opts.MinOverlap) {
+				continue
+			}
+			region, ok := sharedRegion(records[i].Dimensions, records[j].Dimensions, boundary)
+			if !ok {
+				continue
+			}
+			conflicts = append(conflicts, data.DimensionalConflict{
+				FirstIndex:   i,
+				FirstID:      records[i].ID,
+				SecondIndex:  j,
+				SecondID:     records[j].ID,
+				Intersection: r,
+				Duration:     r.Duration(),
+				Dimensions:   region,
+			})
+		}
+	}
+
+	sort.SliceStable(conflicts, func(a, b int) bool {
+		ca, cb := conflicts[a], conflicts[b]
+		if c := compareStarts(ca.Intersection.Start, cb.Intersection.Start); c != 0 {
+			return c < 0
+		}
+		if ca.FirstIndex != cb.FirstIndex {
+			return ca.FirstIndex < cb.FirstIndex
+		}
+		return ca.SecondIndex < cb.SecondIndex
+	})
+	return data.DimensionalOverlapResult{Conflicts: conflicts, Boundary: boundary}, nil
+}
+
+// checkDimensionKinds rejects a dimension that is a range in one record and a
+// set of values in another, as the two cannot be compared.
+func checkDimensionKinds(records []data.DimensionalRecord) error {
+	firstSeen := make(map[string]int)
+	for i, r := range records {
+		for name, d := range r.Dimensions {
+			j, ok := firstSeen[name]
+			if !ok {
+				firstSeen[name] = i
+				continue
+			}
+			if (d.Range == nil) != (records[j].Dimensions[name].Range == nil) {
+				return &FieldError{
+					Field:   fmt.Sprintf("records[%d].dimensions[%s]", i, name),
+					Message: fmt.Sprintf("must be the same kind of dimension as in records[%d], a range or a set of values", j),
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// dimensionBuckets groups the record indexes to sweep together. The pivot is
+// the discrete dimension with the most distinct values; a record without it
+// matches every value and joins every bucket, which also pairs such records
+// with each other. Without any discrete dimension all records form one bucket.
+func dimensionBuckets(records []data.DimensionalRecord) [][]int {
+	distinct := make(map[string]map[string]bool)
+	for _, r := range records {
+		for name, d := range r.Dimensions {
+			if d.Range != nil {
+				continue
+			}
+			if distinct[name] == nil {
+				distinct[name] = make(map[string]bool)
+			}
+			for _, v := range d.Values {
+				distinct[name][v] = true
+			}
+		}
+	}
+	pivot := ""
+	for name, values := range distinct {
+		if pivot == "" || len(values) > len(distinct[pivot]) || (len(values) == len(distinct[pivot]) && name < pivot) {
+			pivot = name
+		}
+	}
+
+	if pivot == "" {
+		all := make([]int, len(records))
+		for i := range records {
+			all[i] = i
+		}
+		return [][]int{all}
+	}
+
+	byValue := make(map[string][]int)
+	var wild []int
+	for i, r := range records {
+		d, ok := r.Dimensions[pivot]
+		if !ok {
+			wild = append(wild, i)
+			continue
+		}
+		for _, v := range d.Values {
+			if bucket := byValue[v]; len(bucket) == 0 || bucket[len(bucket)-1] != i {
+				byValue[v] = append(bucket, i)
+			}
+		}
+	}
+
+	values := make([]string, 0, len(byValue))
+	for v := range byValue {
+		values = append(values, v)
+	}
+	sort.Strings(values)
+	buckets := make([][]int, 0, len(values))
+	for _, v := range values {
+		bucket := append(slices.Clone(byValue[v]), wild...)
+		slices.Sort(bucket)
+		buckets = append(buckets, bucket)
+	}
+	return buckets
+}
+
+// sharedRegion intersects the dimensions of two records. A dimension only one
+// of them names is shared as is, since the other applies to all of it.
+func sharedRegion(d1, d2 map[string]data.Dimension, boundary data.Boundary) (map[string]data.Dimension, bool) {
+	if len(d1) == 0 && len(d2) == 0 {
+		return nil, true
+	}
+	region := make(map[string]data.Dimension, len(d1)+len(d2))
+	for name, d := range d1 {
+		other, ok := d2[name]
+		if !ok {
+			region[name] = d
+			continue
+		}
+		shared, ok := intersectDimension(d, other, boundary)
+		if !ok {
+			return nil, false
+		}
+		region[name] = shared
+	}
+	for name, d := range d2 {
+		if _, ok := d1[name]; !ok {
+			region[name] = d
+		}
+	}
+	return region, true
+}
+
+func intersectDimension(d1, d2 data.Dimension, boundary data.Boundary) (data.Dimension, bool) {
+	if d1.Range != nil {
+		r, ok := decimals.Intersection(interval.Interval[data.Decimal](*d1.Range), interval.Interval[data.Decimal](*d2.Range), boundary)
+		if !ok {
+			return data.Dimension{}, false
+		}
+		shared := data.DecimalRange(r)
+		return data.Dimension{Range: &shared}, true
+	}
+
+	values := make([]string, 0)
+	for _, v := range d1.Values {
+		if slices.Contains(d2.Values, v) && !slices.Contains(values, v) {
+			values = append(values, v)
+		}
+	}
+	return data.Dimension{Values: values}, len(values) > 0
+}
@@ -0,0 +1,148 @@
+package overlap
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func values(v ...string) data.Dimension {
+	return data.Dimension{Values: v}
+}
+
+func between(start, end string) data.Dimension {
+	r := decimalRange(start, end)
+	return data.Dimension{Range: &r}
+}
+
+func taxRule(id, start, end string, dimensions map[string]data.Dimension) data.DimensionalRecord {
+	return data.DimensionalRecord{ID: id, Range: createDateRange(start, end), Dimensions: dimensions}
+}
+
+func TestOverlapService_FindDimensionalOverlaps(t *testing.T) {
+	service := newTestService()
+	records := []data.DimensionalRecord{
+		taxRule("wa-food", "2025-01-01T00:00:00Z", "2026-01-01T00:00:00Z", map[string]data.Dimension{
+			"jurisdiction": values("WA"), "category": values("food", "beverage"),
+		}),
+		taxRule("wa-food-h2", "2025-07-01T00:00:00Z", "2026-07-01T00:00:00Z", map[string]data.Dimension{
+			"jurisdiction": values("WA", "OR"), "category": values("beverage"),
+		}),
+		taxRule("wa-clothing", "2025-01-01T00:00:00Z", "2026-01-01T00:00:00Z", map[string]data.Dimension{
+			"jurisdiction": values("WA"), "category": values("clothing"),
+		}),
+		taxRule("or-food-2024", "2024-01-01T00:00:00Z", "2025-01-01T00:00:00Z", map[string]data.Dimension{
+			"jurisdiction": values("OR"), "category": values("beverage"),
+		}),
+		taxRule("all-clothing", "2025-06-01T00:00:00Z", "2025-07-01T00:00:00Z", map[string]data.Dimension{
+			"category": values("clothing"),
+		}),
+	}
+
+	result, err := service.FindDimensionalOverlaps(records, data.OverlapOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []data.DimensionalConflict{
+		{
+			FirstIndex: 2, FirstID: "wa-clothing", SecondIndex: 4, SecondID: "all-clothing",
+			Intersection: createDateRange("2025-06-01T00:00:00Z", "2025-07-01T00:00:00Z"),
+			Duration:     data.Duration(30 * 24 * time.Hour),
+			Dimensions:   map[string]data.Dimension{"jurisdiction": values("WA"), "category": values("clothing")},
+		},
+		{
+			FirstIndex: 0, FirstID: "wa-food", SecondIndex: 1, SecondID: "wa-food-h2",
+			Intersection: createDateRange("2025-07-01T00:00:00Z", "2026-01-01T00:00:00Z"),
+			Duration:     data.Duration(184 * 24 * time.Hour),
+			Dimensions:   map[string]data.Dimension{"jurisdiction": values("WA"), "category": values("beverage")},
+		},
+	}, result.Conflicts)
+	assert.Equal(t, data.BoundaryClosedOpen, result.Boundary)
+}
+
+func TestOverlapService_FindDimensionalOverlapsWithRanges(t *testing.T) {
+	service := newTestService()
+	records := []data.DimensionalRecord{
+		taxRule("light", "2025-01-01T00:00:00Z", "2026-01-01T00:00:00Z", map[string]data.Dimension{"weight": between("0", "10")}),
+		taxRule("medium", "2025-01-01T00:00:00Z", "2026-01-01T00:00:00Z", map[string]data.Dimension{"weight": between("9.5", "50")}),
+		taxRule("heavy", "2025-01-01T00:00:00Z", "2026-01-01T00:00:00Z", map[string]data.Dimension{"weight": between("50", "1000")}),
+	}
+
+	result, err := service.FindDimensionalOverlaps(records, data.OverlapOptions{})
+	require.NoError(t, err)
+	require.Len(t, result.Conflicts, 1, "medium and heavy only touch at 50")
+	assert.Equal(t, "light", result.Conflicts[0].FirstID)
+	assert.Equal(t, "medium", result.Conflicts[0].SecondID)
+	weight := result.Conflicts[0].Dimensions["weight"].Range
+	assert.Equal(t, "9.5", weight.Start.String())
+	assert.Equal(t, "10", weight.End.String())
+
+	result, err = service.FindDimensionalOverlaps(records, data.OverlapOptions{Boundary: data.BoundaryClosed})
+	require.NoError(t, err)
+	assert.Len(t, result.Conflicts, 2)
+}
+
+func TestOverlapService_FindDimensionalOverlapsMixedKinds(t *testing.T) {
+	service := newTestService()
+	records := []data.DimensionalRecord{
+		taxRule("a", "2025-01-01T00:00:00Z", "2026-01-01T00:00:00Z", map[string]data.Dimension{"zip": values("98101")}),
+		taxRule("b", "2025-01-01T00:00:00Z", "2026-01-01T00:00:00Z", map[string]data.Dimension{"zip": between("98000", "98199")}),
+	}
+
+	_, err := service.FindDimensionalOverlaps(records, data.OverlapOptions{})
+
+	var fieldErr *FieldError
+	require.ErrorAs(t, err, &fieldErr)
+	assert.Equal(t, "records[1].dimensions[zip]", fieldErr.Field)
+}
+
+// The bucketed sweep must find exactly the pairs a comparison of every pair finds.
+func TestOverlapService_FindDimensionalOverlapsMatchesAllPairs(t *testing.T) {
+	service := newTestService()
+	rng := rand.New(rand.NewSource(19))
+	base := mustParseTime("2025-01-01T00:00:00Z")
+	states := []string{"WA", "OR", "CA", "NV", "ID"}
+	categories := []string{"food", "clothing", "software"}
+
+	records := make([]data.DimensionalRecord, 400)
+	for i := range records {
+		start := base.Add(time.Duration(rng.Intn(365)) * 24 * time.Hour)
+		dimensions := map[string]data.Dimension{
+			"category":  values(categories[rng.Intn(len(categories))]),
+			"rate_band": between(fmt.Sprint(rng.Intn(10)), fmt.Sprint(10+rng.Intn(10))),
+		}
+		if rng.Intn(10) > 0 {
+			dimensions["jurisdiction"] = values(states[rng.Intn(len(states))], states[rng.Intn(len(states))])
+		}
+		records[i] = data.DimensionalRecord{
+			ID:         fmt.Sprint(i),
+			Range:      data.DateRange{Start: start, End: start.Add(time.Duration(1+rng.Intn(60)) * 24 * time.Hour)},
+			Dimensions: dimensions,
+		}
+	}
+
+	result, err := service.FindDimensionalOverlaps(records, data.OverlapOptions{})
+	require.NoError(t, err)
+
+	found := make(map[[2]int]bool)
+	for _, c := range result.Conflicts {
+		found[[2]int{c.FirstIndex, c.SecondIndex}] = true
+	}
+	expected := make(map[[2]int]bool)
+	for i := range records {
+		for j := i + 1; j < len(records); j++ {
+			_, inTime := intersection(records[i].Range, records[j].Range, data.BoundaryClosedOpen)
+			_, inDimensions := sharedRegion(records[i].Dimensions, records[j].Dimensions, data.BoundaryClosedOpen)
+			if inTime && inDimensions {
+				expected[[2]int{i, j}] = true
+			}
+		}
+	}
+	assert.NotEmpty(t, expected)
+	assert.Equal(t, expected, found)
+	assert.Len(t, result.Conflicts, len(found), "each pair is reported once")
+}
@@ -0,0 +1,13 @@
+package overlap
+
+// FieldError reports a request field that is well-formed but cannot be used,
+// such as a wall-clock time that a DST change skips. Field is the JSON path of
+// the offending field.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
@@ -0,0 +1,72 @@
+package overlap
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/internal/filing"
+	"github.com/keshu12345/overlap-avalara/internal/zone"
+)
+
+// defaultMaxFilingPeriods is how many filing periods a range may span when the
+// configuration sets no limit: a century of monthly periods.
+const defaultMaxFilingPeriods = 1200
+
+// FilingPeriods returns the filing periods r touches, with the calendar days
+// of each that fall in r. Periods are generated from the one holding the start
+// of r to the one holding its end, and kept when they overlap r under the
+// request's boundary. A range that spans more than maxFilingPeriods periods is
+// rejected.
+func (os *overlapService) FilingPeriods(r data.DateRange, opts data.FilingOptions) (data.FilingPeriodResult, error) {
+	os.Logger.Info("Finding filing periods with overlapservice")
+	boundary := os.boundary(opts.OverlapOptions)
+	result := data.FilingPeriodResult{Periods: make([]data.FilingPeriod, 0), Frequency: opts.Frequency, Boundary: boundary}
+
+	if !r.HasStart() || !r.HasEnd() {
+		return result, &FieldError{Field: "range", Message: "filing periods need a range with a start and an end"}
+	}
+	loc := time.UTC
+	if opts.Zone != "" {
+		var err error
+		if loc, err = zone.Load(opts.Zone); err != nil {
+			return result, &FieldError{Field: "zone", Message: err.Error()}
+		}
+	}
+
+	cal := filing.New(opts.Frequency, time.Month(opts.FiscalYearStart), loc)
+	periods, complete := cal.Periods(r.Start, r.End, os.maxFilingPeriods)
+	if !complete {
+		return result, &FieldError{Field: "range", Message: fmt.Sprintf("the range spans more than %d filing periods", os.maxFilingPeriods)}
+	}
+	for _, p := range periods {
+		period := data.DateRange{Start: p.Start, End: p.End}
+		i, ok := intersection(period, r, boundary)
+		if !ok {
+			continue
+		}
+		i = inZone(i, loc)
+		result.Periods = append(result.Periods, data.FilingPeriod{
+			Label:        p.Label,
+			FiscalYear:   p.FiscalYear,
+			Period:       period,
+			Intersection: i,
+			Duration:     i.Duration(),
+			Days:         touchedDays(i, loc),
+			PeriodDays:   touchedDays(period, loc),
+		})
+	}
+	result.Days = touchedDays(r, loc)
+	return result, nil
+}
+
+// touchedDays counts the calendar days in loc that r touches. The day r ends
+// on is left out when r ends at its midnight, unless r is a single instant.
+func touchedDays(r data.DateRange, loc *time.Location) int {
+	start, end := r.Start.In(loc), r.End.In(loc)
+	last := civilDay(end)
+	if end.After(start) && end.Equal(wallClock(last, loc)) {
+		last = last.AddDate(0, 0, -1)
+	}
+	return int((last.Unix()-civilDay(start).Unix())/(24*60*60)) + 1
+}
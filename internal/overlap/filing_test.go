@@ -0,0 +1,161 @@
+package overlap
+
+import (
+	"testing"
+
+	"github.com/keshu12345/overlap-avalara/config"
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func filingLabels(result data.FilingPeriodResult) []string {
+	labels := make([]string, len(result.Periods))
+	for i, p := range result.Periods {
+		labels[i] = p.Label
+	}
+	return labels
+}
+
+func TestOverlapService_FilingPeriods(t *testing.T) {
+	service := newTestService()
+	r := createDateRange("2025-02-15T12:00:00Z", "2025-05-01T00:00:00Z")
+
+	result, err := service.FilingPeriods(r, data.FilingOptions{Frequency: data.FilingQuarterly})
+
+	require.NoError(t, err)
+	assert.Equal(t, data.FilingQuarterly, result.Frequency)
+	assert.Equal(t, 75, result.Days)
+	assert.Equal(t, []data.FilingPeriod{
+		{
+			Label:        "2025-Q1",
+			FiscalYear:   2025,
+			Period:       createDateRange("2025-01-01T00:00:00Z", "2025-04-01T00:00:00Z"),
+			Intersection: createDateRange("2025-02-15T12:00:00Z", "2025-04-01T00:00:00Z"),
+			Duration:     createDateRange("2025-02-15T12:00:00Z", "2025-04-01T00:00:00Z").Duration(),
+			Days:         45,
+			PeriodDays:   90,
+		},
+		{
+			Label:        "2025-Q2",
+			FiscalYear:   2025,
+			Period:       createDateRange("2025-04-01T00:00:00Z", "2025-07-01T00:00:00Z"),
+			Intersection: createDateRange("2025-04-01T00:00:00Z", "2025-05-01T00:00:00Z"),
+			Duration:     createDateRange("2025-04-01T00:00:00Z", "2025-05-01T00:00:00Z").Duration(),
+			Days:         30,
+			PeriodDays:   91,
+		},
+	}, result.Periods)
+}
+
+func TestOverlapService_FilingPeriodsOptions(t *testing.T) {
+	testCases := []struct {
+		name     string
+		r        data.DateRange
+		opts     data.FilingOptions
+		expected []string
+		days     []int
+	}{
+		{
+			name:     "Fiscal Year From July",
+			r:        createDateRange("2025-06-01T00:00:00Z", "2026-07-01T00:00:00Z"),
+			opts:     data.FilingOptions{Frequency: data.FilingAnnual, FiscalYearStart: 7},
+			expected: []string{"FY2025", "FY2026"},
+			days:     []int{30, 365},
+		},
+		{
+			name:     "Monthly",
+			r:        createDateRange("2024-02-28T00:00:00Z", "2024-03-02T00:00:00Z"),
+			opts:     data.FilingOptions{Frequency: data.FilingMonthly},
+			expected: []string{"2024-02", "2024-03"},
+			days:     []int{2, 1},
+		},
+		{
+			name:     "Semi Annual",
+			r:        createDateRange("2025-06-30T00:00:00Z", "2025-07-01T00:00:01Z"),
+			opts:     data.FilingOptions{Frequency: data.FilingSemiAnnual},
+			expected: []string{"2025-H1", "2025-H2"},
+			days:     []int{1, 1},
+		},
+		{
+			name:     "Days Read In Zone",
+			r:        createDateRange("2025-03-31T12:00:00Z", "2025-04-01T02:00:00Z"),
+			opts:     data.FilingOptions{Frequency: data.FilingQuarterly, Zone: "America/New_York"},
+			expected: []string{"2025-Q1"},
+			days:     []int{1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := newTestService()
+
+			result, err := service.FilingPeriods(tc.r, tc.opts)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, filingLabels(result))
+			days := make([]int, len(result.Periods))
+			for i, p := range result.Periods {
+				days[i] = p.Days
+			}
+			assert.Equal(t, tc.days, days)
+		})
+	}
+}
+
+func TestOverlapService_FilingPeriodsUnderClosedBoundary(t *testing.T) {
+	mockLogger := &MockLogger{}
+	mockLogger.On("Info", mock.Anything).Return()
+	cfg := &config.Configuration{Overlap: config.Overlap{DefaultBoundary: data.BoundaryClosed}}
+	r := createDateRange("2025-03-01T00:00:00Z", "2025-04-01T00:00:00Z")
+	opts := data.FilingOptions{Frequency: data.FilingQuarterly}
+
+	halfOpen, err := newTestService().FilingPeriods(r, opts)
+	require.NoError(t, err)
+	closed, err := New(mockLogger, cfg, nil).FilingPeriods(r, opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"2025-Q1"}, filingLabels(halfOpen))
+	assert.Equal(t, []string{"2025-Q1", "2025-Q2"}, filingLabels(closed), "under [] the range touches the first instant of Q2")
+	assert.Equal(t, 1, closed.Periods[1].Days)
+}
+
+func TestOverlapService_FilingPeriodsRequestBoundary(t *testing.T) {
+	r := createDateRange("2025-03-01T00:00:00Z", "2025-04-01T00:00:00Z")
+	opts := data.FilingOptions{Frequency: data.FilingQuarterly, OverlapOptions: data.OverlapOptions{Boundary: data.BoundaryClosed}}
+
+	result, err := newTestService().FilingPeriods(r, opts)
+
+	require.NoError(t, err)
+	assert.Equal(t, data.BoundaryClosed, result.Boundary)
+	assert.Equal(t, []string{"2025-Q1", "2025-Q2"}, filingLabels(result), "a [] request boundary overrides the server's [)")
+	assert.Equal(t, 1, result.Periods[1].Days)
+}
+
+func TestOverlapService_FilingPeriodsErrors(t *testing.T) {
+	mockLogger := &MockLogger{}
+	mockLogger.On("Info", mock.Anything).Return()
+	cfg := &config.Configuration{Overlap: config.Overlap{MaxFilingPeriods: 12}}
+
+	testCases := []struct {
+		name  string
+		r     data.DateRange
+		opts  data.FilingOptions
+		field string
+	}{
+		{"Open Range", from("2025-01-01T00:00:00Z"), data.FilingOptions{Frequency: data.FilingMonthly}, "range"},
+		{"Too Many Periods", createDateRange("2025-01-01T00:00:00Z", "2026-06-01T00:00:00Z"), data.FilingOptions{Frequency: data.FilingMonthly}, "range"},
+		{"Unknown Zone", createDateRange("2025-01-01T00:00:00Z", "2025-02-01T00:00:00Z"), data.FilingOptions{Frequency: data.FilingMonthly, Zone: "Mars/Olympus_Mons"}, "zone"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := New(mockLogger, cfg, nil).FilingPeriods(tc.r, tc.opts)
+
+			var fieldErr *FieldError
+			require.ErrorAs(t, err, &fieldErr)
+			assert.Equal(t, tc.field, fieldErr.Field)
+		})
+	}
+}
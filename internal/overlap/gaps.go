@@ -0,0 +1,25 @@
+package overlap
+
+import (
+	"github.com/keshu12345/overlap-avalara/data"
+)
+
+// FindGaps returns the free parts of window that no busy range covers, in
+// start order, skipping gaps shorter than opts.MinGap. Padding around the busy
+// ranges, such as changeover time, is not free.
+func (os *overlapService) FindGaps(window data.DateRange, busy []data.DateRange, opts data.GapOptions) data.GapResult {
+	os.Logger.Info("Finding free gaps with overlapservice")
+	boundary := os.boundary(opts.OverlapOptions)
+
+	gaps := make(data.RangeSet, 0)
+	for _, gap := range complement(padAll(busy, opts.OverlapOptions), window, boundary) {
+		if gap.Duration() < opts.MinGap {
+			continue
+		}
+		gaps = append(gaps, gap)
+		if opts.Limit > 0 && len(gaps) == opts.Limit {
+			break
+		}
+	}
+	return data.GapResult{Gaps: gaps, Boundary: boundary}
+}
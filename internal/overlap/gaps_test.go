@@ -0,0 +1,63 @@
+package overlap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOverlapService_FindGaps(t *testing.T) {
+	busy := []data.DateRange{hours(12, 13), hours(9, 10), hours(9, 11), hours(14, 14), hours(16, 19)}
+
+	testCases := []struct {
+		name     string
+		window   data.DateRange
+		opts     data.GapOptions
+		expected data.RangeSet
+	}{
+		{
+			name:     "All Gaps In Start Order",
+			window:   hours(8, 18),
+			expected: data.RangeSet{hours(8, 9), hours(11, 12), hours(13, 16)},
+		},
+		{
+			name:     "Minimum Gap Length",
+			window:   hours(8, 18),
+			opts:     data.GapOptions{MinGap: data.Duration(90 * time.Minute)},
+			expected: data.RangeSet{hours(13, 16)},
+		},
+		{
+			name:     "Limit",
+			window:   hours(8, 18),
+			opts:     data.GapOptions{Limit: 2},
+			expected: data.RangeSet{hours(8, 9), hours(11, 12)},
+		},
+		{
+			name:     "Busy Ranges Outside The Window Are Ignored",
+			window:   hours(10, 12),
+			expected: data.RangeSet{hours(11, 12)},
+		},
+		{
+			name:     "Window Fully Busy",
+			window:   hours(16, 18),
+			expected: data.RangeSet{},
+		},
+		{
+			name:     "Half-Open Instant Busy Range Is Empty",
+			window:   hours(13, 16),
+			expected: data.RangeSet{hours(13, 16)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := newTestService()
+
+			result := service.FindGaps(tc.window, busy, tc.opts)
+
+			assert.Equal(t, tc.expected, result.Gaps)
+		})
+	}
+}
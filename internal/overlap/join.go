@@ -0,0 +1,52 @@
+package overlap
+
+import (
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/internal/interval"
+)
+
+// Join matches the left ranges against the right ranges and hands every row
+// to emit as soon as it is found, so a caller can stream a large join. Pairs
+// come in order of their intersection start; in left_outer mode the left
+// ranges that matched nothing follow, in request order. Join stops at the
+// first error emit returns.
+func (os *overlapService) Join(left, right []data.IdentifiedRange, opts data.JoinOptions, emit func(data.JoinRow) error) (data.JoinSummary, error) {
+	os.Logger.Info("Joining range sets with overlapservice")
+	boundary := os.boundary(opts.OverlapOptions)
+	summary := data.JoinSummary{Boundary: boundary}
+
+	paddedLeft, paddedRight := padIdentified(left, opts.OverlapOptions), padIdentified(right, opts.OverlapOptions)
+	matched := make([]bool, len(left))
+	err := times.Join(toIntervals(paddedLeft), toIntervals(paddedRight), boundary, func(i, j int, p interval.Interval[time.Time]) error {
+		r := fromInterval(p)
+		if !longEnough(r.Duration(), paddedLeft[i], paddedRight[j], opts.MinOverlap) {
+			return nil
+		}
+		matched[i] = true
+		summary.Matched++
+		return emit(data.JoinRow{
+			LeftIndex:    i,
+			LeftID:       left[i].ID,
+			RightIndex:   &j,
+			RightID:      right[j].ID,
+			Intersection: &r,
+			Duration:     r.Duration(),
+		})
+	})
+	if err != nil || opts.Mode != data.JoinLeftOuter {
+		return summary, err
+	}
+
+	for i, ok := range matched {
+		if ok {
+			continue
+		}
+		summary.Unmatched++
+		if err := emit(data.JoinRow{LeftIndex: i, LeftID: left[i].ID}); err != nil {
+			return summary, err
+		}
+	}
+	return summary, nil
+}
@@ -0,0 +1,134 @@
+package overlap
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func collectJoin(t *testing.T, left, right []data.IdentifiedRange, opts data.JoinOptions) ([]data.JoinRow, data.JoinSummary) {
+	t.Helper()
+	var rows []data.JoinRow
+	summary, err := newTestService().Join(left, right, opts, func(row data.JoinRow) error {
+		rows = append(rows, row)
+		return nil
+	})
+	require.NoError(t, err)
+	return rows, summary
+}
+
+func joined(left int, leftID string, right int, rightID string, r data.DateRange) data.JoinRow {
+	return data.JoinRow{LeftIndex: left, LeftID: leftID, RightIndex: &right, RightID: rightID, Intersection: &r, Duration: r.Duration()}
+}
+
+func TestOverlapService_Join(t *testing.T) {
+	transactions := []data.IdentifiedRange{
+		identified("txn-1", "2025-01-15T00:00:00Z", "2025-02-15T00:00:00Z"),
+		identified("txn-2", "2024-06-01T00:00:00Z", "2024-07-01T00:00:00Z"),
+		identified("txn-3", "2025-03-01T00:00:00Z", "2025-03-31T00:00:00Z"),
+	}
+	rates := []data.IdentifiedRange{
+		identified("rate-2025a", "2025-01-01T00:00:00Z", "2025-02-01T00:00:00Z"),
+		{ID: "rate-2025b", Range: from("2025-02-01T00:00:00Z")},
+	}
+
+	t.Run("Inner", func(t *testing.T) {
+		rows, summary := collectJoin(t, transactions, rates, data.JoinOptions{})
+
+		assert.Equal(t, []data.JoinRow{
+			joined(0, "txn-1", 0, "rate-2025a", createDateRange("2025-01-15T00:00:00Z", "2025-02-01T00:00:00Z")),
+			joined(0, "txn-1", 1, "rate-2025b", createDateRange("2025-02-01T00:00:00Z", "2025-02-15T00:00:00Z")),
+			joined(2, "txn-3", 1, "rate-2025b", createDateRange("2025-03-01T00:00:00Z", "2025-03-31T00:00:00Z")),
+		}, rows)
+		assert.Equal(t, data.JoinSummary{Matched: 3, Boundary: data.BoundaryClosedOpen}, summary)
+	})
+
+	t.Run("Left Outer", func(t *testing.T) {
+		rows, summary := collectJoin(t, transactions, rates, data.JoinOptions{Mode: data.JoinLeftOuter})
+
+		require.Len(t, rows, 4)
+		assert.Equal(t, data.JoinRow{LeftIndex: 1, LeftID: "txn-2"}, rows[3], "unmatched rows come last")
+		assert.Equal(t, data.JoinSummary{Matched: 3, Unmatched: 1, Boundary: data.BoundaryClosedOpen}, summary)
+	})
+
+	t.Run("Empty Right Side", func(t *testing.T) {
+		rows, summary := collectJoin(t, transactions, nil, data.JoinOptions{Mode: data.JoinLeftOuter})
+
+		assert.Len(t, rows, 3)
+		assert.Equal(t, 3, summary.Unmatched)
+	})
+
+	t.Run("Min Overlap Leaves Rows Unmatched", func(t *testing.T) {
+		opts := data.JoinOptions{Mode: data.JoinLeftOuter, OverlapOptions: data.OverlapOptions{
+			MinOverlap: &data.MinOverlap{Duration: data.Duration(15 * 24 * time.Hour)},
+		}}
+		rows, _ := collectJoin(t, transactions, rates, opts)
+
+		assert.Equal(t, []data.JoinRow{
+			joined(0, "txn-1", 0, "rate-2025a", createDateRange("2025-01-15T00:00:00Z", "2025-02-01T00:00:00Z")),
+			joined(2, "txn-3", 1, "rate-2025b", createDateRange("2025-03-01T00:00:00Z", "2025-03-31T00:00:00Z")),
+			{LeftIndex: 1, LeftID: "txn-2"},
+		}, rows)
+	})
+}
+
+func TestOverlapService_JoinStopsOnEmitError(t *testing.T) {
+	left := []data.IdentifiedRange{identified("a", "2025-01-01T00:00:00Z", "2025-02-01T00:00:00Z")}
+	right := []data.IdentifiedRange{
+		identified("x", "2025-01-01T00:00:00Z", "2025-01-10T00:00:00Z"),
+		identified("y", "2025-01-05T00:00:00Z", "2025-01-20T00:00:00Z"),
+	}
+	closed := errors.New("client went away")
+
+	calls := 0
+	summary, err := newTestService().Join(left, right, data.JoinOptions{}, func(data.JoinRow) error {
+		calls++
+		return closed
+	})
+
+	assert.ErrorIs(t, err, closed)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, 1, summary.Matched)
+}
+
+// The merge join must find exactly the pairs a comparison of every pair finds.
+func TestOverlapService_JoinMatchesAllPairs(t *testing.T) {
+	rng := rand.New(rand.NewSource(20))
+	base := mustParseTime("2025-01-01T00:00:00Z")
+	randomRanges := func(n int) []data.IdentifiedRange {
+		ranges := make([]data.IdentifiedRange, n)
+		for i := range ranges {
+			start := base.Add(time.Duration(rng.Intn(1000)) * time.Hour)
+			ranges[i] = data.IdentifiedRange{ID: fmt.Sprint(i), Range: data.DateRange{Start: start, End: start.Add(time.Duration(rng.Intn(48)) * time.Hour)}}
+		}
+		return ranges
+	}
+	left, right := randomRanges(300), randomRanges(200)
+
+	rows, summary := collectJoin(t, left, right, data.JoinOptions{})
+
+	found := make(map[[2]int]bool)
+	for i, row := range rows {
+		found[[2]int{row.LeftIndex, *row.RightIndex}] = true
+		if i > 0 {
+			assert.False(t, row.Intersection.Start.Before(rows[i-1].Intersection.Start), "rows come in intersection order")
+		}
+	}
+	expected := make(map[[2]int]bool)
+	for i := range left {
+		for j := range right {
+			if _, ok := intersection(left[i].Range, right[j].Range, data.BoundaryClosedOpen); ok {
+				expected[[2]int{i, j}] = true
+			}
+		}
+	}
+	assert.NotEmpty(t, expected)
+	assert.Equal(t, expected, found)
+	assert.Equal(t, len(expected), summary.Matched)
+}
@@ -0,0 +1,37 @@
+package overlap
+
+import (
+	"github.com/keshu12345/overlap-avalara/data"
+)
+
+// jaccard returns the length of the overlap of r1 and r2 over the length of
+// their union. The ranges overlap, so their union is the range spanning both.
+func jaccard(overlap data.Duration, r1, r2 data.DateRange) float64 {
+	union := data.DateRange{Start: earlierStart(r1.Start, r2.Start), End: laterEnd(r1.End, r2.End)}
+	return coverage(overlap, union)
+}
+
+// longEnough reports whether an overlap of r1 and r2 meets the minimum of the
+// request. r1 and r2 are the ranges as compared, padding included.
+func longEnough(overlap data.Duration, r1, r2 data.DateRange, min *data.MinOverlap) bool {
+	if min == nil {
+		return true
+	}
+	if overlap < min.Duration {
+		return false
+	}
+	if min.Percent == 0 {
+		return true
+	}
+	share := min.Percent / 100
+	enough1, enough2 := coverage(overlap, r1) >= share, coverage(overlap, r2) >= share
+	switch min.Of {
+	case data.MinOverlapOfBoth:
+		return enough1 && enough2
+	case data.MinOverlapOfRange1:
+		return enough1
+	case data.MinOverlapOfRange2:
+		return enough2
+	}
+	return enough1 || enough2
+}
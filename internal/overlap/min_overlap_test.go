@@ -0,0 +1,95 @@
+package overlap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOverlapService_CheckWithMinOverlap(t *testing.T) {
+	// One hour of overlap: half of range1, a quarter of range2.
+	range1 := createDateRange("2025-07-01T10:00:00Z", "2025-07-01T12:00:00Z")
+	range2 := createDateRange("2025-07-01T11:00:00Z", "2025-07-01T15:00:00Z")
+
+	testCases := []struct {
+		name     string
+		min      *data.MinOverlap
+		expected bool
+	}{
+		{"No Minimum", nil, true},
+		{"Duration Met", &data.MinOverlap{Duration: data.Duration(time.Hour)}, true},
+		{"Duration Not Met", &data.MinOverlap{Duration: data.Duration(61 * time.Minute)}, false},
+		{"Percent Of Either", &data.MinOverlap{Percent: 50}, true},
+		{"Percent Of Either Not Met", &data.MinOverlap{Percent: 51}, false},
+		{"Percent Of Both", &data.MinOverlap{Percent: 30, Of: data.MinOverlapOfBoth}, false},
+		{"Percent Of Range1", &data.MinOverlap{Percent: 50, Of: data.MinOverlapOfRange1}, true},
+		{"Percent Of Range2", &data.MinOverlap{Percent: 50, Of: data.MinOverlapOfRange2}, false},
+		{"Duration And Percent Both Apply", &data.MinOverlap{Duration: data.Duration(2 * time.Hour), Percent: 10}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := newTestService()
+			opts := data.OverlapOptions{MinOverlap: tc.min}
+
+			assert.Equal(t, tc.expected, service.Check(range1, range2, opts))
+			result := service.Intersect(range1, range2, opts)
+			assert.Equal(t, tc.expected, result.Overlap, "Intersect must agree with Check")
+			if !tc.expected {
+				assert.Nil(t, result.Intersection)
+			}
+		})
+	}
+}
+
+func TestOverlapService_JaccardOfOpenEndedRanges(t *testing.T) {
+	service := newTestService()
+
+	result := service.Intersect(from("2025-01-01T00:00:00Z"), from("2026-01-01T00:00:00Z"), data.OverlapOptions{})
+	require.True(t, result.Overlap)
+	assert.Equal(t, 1.0, result.Jaccard)
+
+	result = service.Intersect(from("2025-01-01T00:00:00Z"), createDateRange("2026-01-01T00:00:00Z", "2026-02-01T00:00:00Z"), data.OverlapOptions{})
+	require.True(t, result.Overlap)
+	assert.Equal(t, 0.0, result.Jaccard)
+}
+
+func TestOverlapService_FindOverlapsWithMinOverlap(t *testing.T) {
+	service := newTestService()
+	ranges := []data.IdentifiedRange{
+		identified("a", "2025-07-01T10:00:00Z", "2025-07-01T12:00:00Z"),
+		identified("b", "2025-07-01T11:59:59Z", "2025-07-01T13:00:00Z"),
+		identified("c", "2025-07-01T10:30:00Z", "2025-07-01T11:30:00Z"),
+	}
+
+	result := service.FindOverlaps(ranges, data.OverlapOptions{MinOverlap: &data.MinOverlap{Duration: data.Duration(time.Minute)}})
+
+	require.Len(t, result.Pairs, 1, "the one-second overlap of a and b is noise")
+	pair := result.Pairs[0]
+	assert.Equal(t, "a", pair.FirstID)
+	assert.Equal(t, "c", pair.SecondID)
+	assert.Equal(t, 0.5, pair.FirstCoverage)
+	assert.Equal(t, 1.0, pair.SecondCoverage)
+	assert.Equal(t, 0.5, pair.Jaccard)
+}
+
+func TestOverlapService_FindRecurringOverlapsWithMinOverlap(t *testing.T) {
+	service := newTestService()
+	mondays := recurring("2025-07-07T09:00:00Z", 2*time.Hour, "FREQ=WEEKLY;BYDAY=MO;COUNT=3")
+	meetings := []data.Schedule{
+		fixed(createDateRange("2025-07-07T10:59:00Z", "2025-07-07T12:00:00Z")),
+		fixed(createDateRange("2025-07-14T10:00:00Z", "2025-07-14T12:00:00Z")),
+	}
+	opts := data.RecurrenceOptions{OverlapOptions: data.OverlapOptions{MinOverlap: &data.MinOverlap{Percent: 25, Of: data.MinOverlapOfRange1}}}
+
+	result, err := service.FindRecurringOverlaps(mondays, meetings[0], opts)
+	require.NoError(t, err)
+	assert.False(t, result.Overlap)
+
+	result, err = service.FindRecurringOverlaps(mondays, meetings[1], opts)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2025-07-14T10:00:00Z"}, clashStarts(result))
+}
@@ -0,0 +1,45 @@
+package overlap
+
+import (
+	"github.com/keshu12345/overlap-avalara/data"
+)
+
+// FindOverlaps reports every overlapping pair in ranges, using the sweep of
+// the interval engine so that only ranges that can still overlap are
+// compared. Pairs that overlap by less than the minimum of the request are
+// left out.
+func (os *overlapService) FindOverlaps(ranges []data.IdentifiedRange, opts data.OverlapOptions) data.MultiOverlapResult {
+	os.Logger.Info("Finding overlapping pairs with overlapservice")
+	boundary := os.boundary(opts)
+
+	padded := padIdentified(ranges, opts)
+
+	pairs := make([]data.OverlapPair, 0)
+	for _, p := range times.Overlaps(toIntervals(padded), boundary) {
+		r := fromInterval(p.Intersection)
+		if !longEnough(r.Duration(), padded[p.First], padded[p.Second], opts.MinOverlap) {
+			continue
+		}
+		pair := newOverlapPair(ranges, padded, p.First, p.Second, r)
+		pair.RawIntersection = rawIntersection(ranges[p.First].Range, ranges[p.Second].Range, opts, boundary)
+		pairs = append(pairs, pair)
+	}
+	return data.MultiOverlapResult{Pairs: pairs, Boundary: boundary}
+}
+
+// newOverlapPair describes the overlap r of ranges i and j, whose padded
+// versions are given in padded.
+func newOverlapPair(ranges []data.IdentifiedRange, padded []data.DateRange, i, j int, r data.DateRange) data.OverlapPair {
+	overlap := r.Duration()
+	return data.OverlapPair{
+		FirstIndex:     i,
+		FirstID:        ranges[i].ID,
+		SecondIndex:    j,
+		SecondID:       ranges[j].ID,
+		Intersection:   r,
+		Duration:       overlap,
+		FirstCoverage:  coverage(overlap, padded[i]),
+		SecondCoverage: coverage(overlap, padded[j]),
+		Jaccard:        jaccard(overlap, padded[i], padded[j]),
+	}
+}
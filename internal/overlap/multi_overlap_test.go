@@ -0,0 +1,100 @@
+package overlap
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestService() OverlapService {
+	mockLogger := &MockLogger{}
+	mockLogger.On("Info", mock.Anything).Return()
+	return New(mockLogger, nil, nil)
+}
+
+func identified(id, start, end string) data.IdentifiedRange {
+	return data.IdentifiedRange{ID: id, Range: createDateRange(start, end)}
+}
+
+func TestOverlapService_FindOverlaps(t *testing.T) {
+	service := newTestService()
+
+	ranges := []data.IdentifiedRange{
+		identified("a", "2025-07-01T10:00:00Z", "2025-07-01T12:00:00Z"),
+		identified("b", "2025-07-01T11:00:00Z", "2025-07-01T13:00:00Z"),
+		identified("c", "2025-07-01T12:00:00Z", "2025-07-01T14:00:00Z"),
+		identified("d", "2025-07-01T15:00:00Z", "2025-07-01T16:00:00Z"),
+		identified("", "2025-07-01T09:00:00Z", "2025-07-01T17:00:00Z"),
+	}
+
+	result := service.FindOverlaps(ranges, data.OverlapOptions{})
+
+	assert.Equal(t, data.BoundaryClosedOpen, result.Boundary)
+	got := make([]string, 0, len(result.Pairs))
+	for _, p := range result.Pairs {
+		got = append(got, fmt.Sprintf("%d-%d", p.FirstIndex, p.SecondIndex))
+	}
+	assert.Equal(t, []string{"0-4", "0-1", "1-4", "1-2", "2-4", "3-4"}, got)
+
+	ab := result.Pairs[1]
+	assert.Equal(t, "a", ab.FirstID)
+	assert.Equal(t, "b", ab.SecondID)
+	assert.Equal(t, createDateRange("2025-07-01T11:00:00Z", "2025-07-01T12:00:00Z"), ab.Intersection)
+	assert.Equal(t, data.Duration(time.Hour), ab.Duration)
+	assert.Empty(t, result.Pairs[0].SecondID, "ranges without an ID are reported by index only")
+}
+
+func TestOverlapService_FindOverlapsClosedBoundary(t *testing.T) {
+	service := newTestService()
+
+	ranges := []data.IdentifiedRange{
+		identified("a", "2025-07-01T10:00:00Z", "2025-07-01T11:00:00Z"),
+		identified("b", "2025-07-01T11:00:00Z", "2025-07-01T12:00:00Z"),
+	}
+
+	assert.Empty(t, service.FindOverlaps(ranges, data.OverlapOptions{}).Pairs)
+
+	result := service.FindOverlaps(ranges, data.OverlapOptions{Boundary: data.BoundaryClosed})
+	require.Len(t, result.Pairs, 1)
+	assert.Equal(t, data.Duration(0), result.Pairs[0].Duration)
+}
+
+func TestOverlapService_FindOverlapsMatchesPairwiseCheck(t *testing.T) {
+	service := newTestService()
+	rnd := rand.New(rand.NewSource(42))
+	base := mustParseTime("2025-07-01T00:00:00Z")
+
+	ranges := make([]data.IdentifiedRange, 120)
+	for i := range ranges {
+		start := base.Add(time.Duration(rnd.Intn(4000)) * time.Minute)
+		ranges[i] = data.IdentifiedRange{
+			ID:    fmt.Sprint(i),
+			Range: data.DateRange{Start: start, End: start.Add(time.Duration(rnd.Intn(120)) * time.Minute)},
+		}
+	}
+
+	for _, boundary := range []data.Boundary{data.BoundaryClosed, data.BoundaryClosedOpen} {
+		opts := data.OverlapOptions{Boundary: boundary}
+		expected := 0
+		for i := range ranges {
+			for j := i + 1; j < len(ranges); j++ {
+				if service.Check(ranges[i].Range, ranges[j].Range, opts) {
+					expected++
+				}
+			}
+		}
+
+		pairs := service.FindOverlaps(ranges, opts).Pairs
+		assert.Len(t, pairs, expected, "boundary %s", boundary)
+		for _, p := range pairs {
+			assert.Less(t, p.FirstIndex, p.SecondIndex)
+			assert.True(t, service.Check(ranges[p.FirstIndex].Range, ranges[p.SecondIndex].Range, opts))
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package overlap
+
+import (
+	"math"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/internal/interval"
+)
+
+// ints and decimals are the interval engines behind the numeric range types.
+var (
+	ints     = interval.Ordered[int64]()
+	decimals = interval.New(data.Decimal.Cmp)
+)
+
+func (os *overlapService) IntersectInts(r1, r2 data.IntRange, b data.Boundary) data.NumericOverlapResult[int64] {
+	os.Logger.Info("Computing integer range intersection with overlapservice")
+	return intersectNumeric(ints, countInts, r1, r2, os.boundary(data.OverlapOptions{Boundary: b}))
+}
+
+func (os *overlapService) IntersectDecimals(r1, r2 data.DecimalRange, b data.Boundary) data.NumericOverlapResult[data.Decimal] {
+	os.Logger.Info("Computing decimal range intersection with overlapservice")
+	return intersectNumeric(decimals, measureDecimals, r1, r2, os.boundary(data.OverlapOptions{Boundary: b}))
+}
+
+func (os *overlapService) FindIntOverlaps(ranges []data.IdentifiedNumericRange[int64], b data.Boundary) data.NumericPairsResult[int64] {
+	os.Logger.Info("Finding overlapping integer ranges with overlapservice")
+	return numericPairs(ints, ranges, os.boundary(data.OverlapOptions{Boundary: b}))
+}
+
+func (os *overlapService) FindDecimalOverlaps(ranges []data.IdentifiedNumericRange[data.Decimal], b data.Boundary) data.NumericPairsResult[data.Decimal] {
+	os.Logger.Info("Finding overlapping decimal ranges with overlapservice")
+	return numericPairs(decimals, ranges, os.boundary(data.OverlapOptions{Boundary: b}))
+}
+
+// countInts returns how many integers lie between start and end under
+// boundary b, reporting false when the count does not fit in an int64, which
+// only happens for ranges spanning most of the type.
+func countInts(start, end int64, b data.Boundary) (int64, bool) {
+	d := end - start
+	if (d >= 0) != (end >= start) {
+		return 0, false
+	}
+	switch {
+	case hasClosedStart(b) && hasClosedEnd(b):
+		return d + 1, d < math.MaxInt64
+	case !hasClosedStart(b) && !hasClosedEnd(b):
+		return max(d-1, 0), true
+	}
+	return d, true
+}
+
+// measureDecimals returns the distance from start to end. A single point has
+// no size on a continuous scale, so the boundary does not change it.
+func measureDecimals(start, end data.Decimal, _ data.Boundary) (data.Decimal, bool) {
+	return end.Sub(start), true
+}
+
+// intersectNumeric describes the overlap of r1 and r2 on engine e; length
+// sizes a bounded intersection under the boundary.
+func intersectNumeric[T any](e interval.Engine[T], length func(start, end T, b data.Boundary) (T, bool), r1, r2 data.NumericRange[T], boundary data.Boundary) data.NumericOverlapResult[T] {
+	i1, i2 := interval.Interval[T](r1), interval.Interval[T](r2)
+	result := data.NumericOverlapResult[T]{Relation: e.Relate(i1, i2), Boundary: boundary}
+
+	r, ok := e.Intersection(i1, i2, boundary)
+	if !ok {
+		return result
+	}
+	intersection := data.NumericRange[T](r)
+	result.Overlap = true
+	result.Intersection = &intersection
+	if r.Start != nil && r.End != nil {
+		if l, ok := length(*r.Start, *r.End, boundary); ok {
+			result.Length = &l
+		}
+	}
+	return result
+}
+
+func numericPairs[T any](e interval.Engine[T], ranges []data.IdentifiedNumericRange[T], boundary data.Boundary) data.NumericPairsResult[T] {
+	set := make([]interval.Interval[T], len(ranges))
+	for i, r := range ranges {
+		set[i] = interval.Interval[T](r.Range)
+	}
+
+	pairs := make([]data.NumericPair[T], 0)
+	for _, p := range e.Overlaps(set, boundary) {
+		pairs = append(pairs, data.NumericPair[T]{
+			FirstIndex:   p.First,
+			FirstID:      ranges[p.First].ID,
+			SecondIndex:  p.Second,
+			SecondID:     ranges[p.Second].ID,
+			Intersection: data.NumericRange[T](p.Intersection),
+		})
+	}
+	return data.NumericPairsResult[T]{Pairs: pairs, Boundary: boundary}
+}
@@ -0,0 +1,132 @@
+package overlap
+
+import (
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func intRange(start, end int64) data.IntRange {
+	return data.IntRange{Start: &start, End: &end}
+}
+
+func decimal(s string) data.Decimal {
+	d, err := data.ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func decimalRange(start, end string) data.DecimalRange {
+	s, e := decimal(start), decimal(end)
+	return data.DecimalRange{Start: &s, End: &e}
+}
+
+func TestOverlapService_IntersectInts(t *testing.T) {
+	testCases := []struct {
+		name         string
+		r1, r2       data.IntRange
+		boundary     data.Boundary
+		overlap      bool
+		intersection data.IntRange
+		length       *int64
+		relation     data.Relation
+	}{
+		{"ZIP Blocks Overlap", intRange(10000, 10500), intRange(10400, 11000), "", true, intRange(10400, 10500), ptr(int64(100)), data.RelationOverlaps},
+		{"Touching Half Open", intRange(0, 100), intRange(100, 200), "", false, data.IntRange{}, nil, data.RelationMeets},
+		{"Touching Closed", intRange(0, 100), intRange(100, 200), data.BoundaryClosed, true, intRange(100, 100), ptr(int64(1)), data.RelationMeets},
+		{"Closed Counts Both Ends", intRange(10000, 10099), intRange(10050, 10200), data.BoundaryClosed, true, intRange(10050, 10099), ptr(int64(50)), data.RelationOverlaps},
+		{"Open Drops Both Ends", intRange(10000, 10099), intRange(10050, 10200), data.BoundaryOpen, true, intRange(10050, 10099), ptr(int64(48)), data.RelationOverlaps},
+		{"Open With No Integer Inside", intRange(0, 2), intRange(1, 5), data.BoundaryOpen, true, intRange(1, 2), ptr(int64(0)), data.RelationOverlaps},
+		{"Closed Count Beyond int64", intRange(0, math.MaxInt64), intRange(0, math.MaxInt64), data.BoundaryClosed, true, intRange(0, math.MaxInt64), nil, data.RelationEquals},
+		{"Unbounded Intersection", data.IntRange{Start: ptr(int64(50))}, data.IntRange{Start: ptr(int64(70))}, "", true, data.IntRange{Start: ptr(int64(70))}, nil, data.RelationFinishedBy},
+		{"Length Beyond int64", intRange(math.MinInt64, math.MaxInt64), intRange(math.MinInt64, math.MaxInt64), "", true, intRange(math.MinInt64, math.MaxInt64), nil, data.RelationEquals},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := newTestService()
+
+			result := service.IntersectInts(tc.r1, tc.r2, tc.boundary)
+
+			assert.Equal(t, tc.overlap, result.Overlap)
+			assert.Equal(t, tc.relation, result.Relation)
+			assert.Equal(t, tc.length, result.Length)
+			if tc.overlap {
+				require.NotNil(t, result.Intersection)
+				assert.Equal(t, tc.intersection, *result.Intersection)
+			} else {
+				assert.Nil(t, result.Intersection)
+			}
+		})
+	}
+}
+
+func TestOverlapService_IntersectDecimals(t *testing.T) {
+	service := newTestService()
+
+	// Bounds that differ beyond float64 precision.
+	result := service.IntersectDecimals(
+		decimalRange("0.10000000000000000001", "5.5"),
+		decimalRange("0.1", "0.10000000000000000002"),
+		data.BoundaryClosed,
+	)
+
+	require.True(t, result.Overlap)
+	assert.Equal(t, "0.10000000000000000001", result.Intersection.Start.String())
+	assert.Equal(t, "0.10000000000000000002", result.Intersection.End.String())
+	assert.Equal(t, "0.00000000000000000001", result.Length.String())
+	assert.Equal(t, data.RelationOverlappedBy, result.Relation)
+	assert.Equal(t, data.BoundaryClosed, result.Boundary)
+
+	result = service.IntersectDecimals(decimalRange("0", "0.1"), decimalRange("0.10000000000000000001", "1"), data.BoundaryClosed)
+	assert.False(t, result.Overlap)
+	assert.Equal(t, data.RelationBefore, result.Relation)
+}
+
+func TestOverlapService_IntersectDecimalsAtTheLimits(t *testing.T) {
+	service := newTestService()
+
+	result := service.IntersectDecimals(decimalRange("1e-1000", "1"), decimalRange("0", "3e-1000"), data.BoundaryClosed)
+
+	require.True(t, result.Overlap)
+	assert.Equal(t, "0."+strings.Repeat("0", 999)+"2", result.Length.String())
+	assert.Equal(t, "1/3", data.NewDecimal(big.NewRat(1, 3)).String())
+
+	_, err := data.ParseDecimal("1e-1001")
+	assert.EqualError(t, err, `"1e-1001" has an exponent beyond 1000 either way`)
+	_, err = data.ParseDecimal(strings.Repeat("9", 1001))
+	assert.Error(t, err)
+}
+
+func TestOverlapService_FindNumericOverlaps(t *testing.T) {
+	service := newTestService()
+
+	ints := service.FindIntOverlaps([]data.IdentifiedNumericRange[int64]{
+		{ID: "light", Range: intRange(0, 500)},
+		{ID: "heavy", Range: data.IntRange{Start: ptr(int64(2000))}},
+		{ID: "medium", Range: intRange(400, 2000)},
+	}, "")
+	assert.Equal(t, data.BoundaryClosedOpen, ints.Boundary)
+	assert.Equal(t, []data.NumericPair[int64]{
+		{FirstIndex: 0, FirstID: "light", SecondIndex: 2, SecondID: "medium", Intersection: intRange(400, 500)},
+	}, ints.Pairs)
+
+	decimals := service.FindDecimalOverlaps([]data.IdentifiedNumericRange[data.Decimal]{
+		{ID: "a", Range: decimalRange("0", "1/3")},
+		{ID: "b", Range: decimalRange("0.3333", "1")},
+	}, data.BoundaryClosed)
+	require.Len(t, decimals.Pairs, 1)
+	assert.Equal(t, "0.3333", decimals.Pairs[0].Intersection.Start.String())
+	assert.Equal(t, "1/3", decimals.Pairs[0].Intersection.End.String())
+}
@@ -0,0 +1,107 @@
+package overlap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func from(start string) data.DateRange {
+	return data.DateRange{Start: mustParseTime(start)}
+}
+
+func until(end string) data.DateRange {
+	return data.DateRange{End: mustParseTime(end)}
+}
+
+func TestOverlapService_CheckOpenEndedRanges(t *testing.T) {
+	testCases := []struct {
+		name     string
+		range1   data.DateRange
+		range2   data.DateRange
+		boundary data.Boundary
+		expected bool
+	}{
+		{"Open End Overlaps Later Range", from("2025-01-01T00:00:00Z"), createDateRange("2030-01-01T00:00:00Z", "2030-02-01T00:00:00Z"), data.BoundaryClosedOpen, true},
+		{"Open End Misses Earlier Range", from("2025-01-01T00:00:00Z"), createDateRange("2024-01-01T00:00:00Z", "2024-02-01T00:00:00Z"), data.BoundaryClosedOpen, false},
+		{"Open Start Overlaps Earlier Range", until("2025-01-01T00:00:00Z"), createDateRange("1990-01-01T00:00:00Z", "1990-02-01T00:00:00Z"), data.BoundaryClosedOpen, true},
+		{"Open Start And Open End Overlap", until("2025-01-01T00:00:00Z"), from("2024-01-01T00:00:00Z"), data.BoundaryClosedOpen, true},
+		{"Open Start Meets Open End", until("2025-01-01T00:00:00Z"), from("2025-01-01T00:00:00Z"), data.BoundaryClosedOpen, false},
+		{"Closed Open Start Meets Open End", until("2025-01-01T00:00:00Z"), from("2025-01-01T00:00:00Z"), data.BoundaryClosed, true},
+		{"Two Open Ends Always Overlap", from("2025-01-01T00:00:00Z"), from("2099-01-01T00:00:00Z"), data.BoundaryOpen, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := newTestService()
+			opts := data.OverlapOptions{Boundary: tc.boundary}
+
+			assert.Equal(t, tc.expected, service.Check(tc.range1, tc.range2, opts))
+			assert.Equal(t, tc.expected, service.Check(tc.range2, tc.range1, opts))
+		})
+	}
+}
+
+func TestOverlapService_IntersectOpenEndedRanges(t *testing.T) {
+	service := newTestService()
+
+	t.Run("Unbounded Intersection", func(t *testing.T) {
+		result := service.Intersect(from("2025-01-01T00:00:00Z"), from("2026-01-01T00:00:00Z"), data.OverlapOptions{})
+
+		require.True(t, result.Overlap)
+		assert.Equal(t, from("2026-01-01T00:00:00Z"), *result.Intersection)
+		assert.Equal(t, data.InfiniteDuration, result.Duration)
+		assert.Equal(t, 1.0, result.Range1Coverage)
+		assert.Equal(t, 1.0, result.Range2Coverage)
+	})
+
+	t.Run("Bounded Intersection Of An Open Range", func(t *testing.T) {
+		result := service.Intersect(from("2025-01-01T00:00:00Z"), createDateRange("2024-12-31T00:00:00Z", "2025-01-02T00:00:00Z"), data.OverlapOptions{})
+
+		require.True(t, result.Overlap)
+		assert.Equal(t, createDateRange("2025-01-01T00:00:00Z", "2025-01-02T00:00:00Z"), *result.Intersection)
+		assert.Equal(t, data.Duration(24*time.Hour), result.Duration)
+		assert.Equal(t, 0.0, result.Range1Coverage)
+		assert.Equal(t, 0.5, result.Range2Coverage)
+	})
+}
+
+func TestOverlapService_RelateOpenEndedRanges(t *testing.T) {
+	service := newTestService()
+
+	assert.Equal(t, data.RelationEquals, service.Relate(from("2025-01-01T00:00:00Z"), from("2025-01-01T00:00:00Z"), data.OverlapOptions{}))
+	assert.Equal(t, data.RelationStartedBy, service.Relate(from("2025-01-01T00:00:00Z"), createDateRange("2025-01-01T00:00:00Z", "2025-02-01T00:00:00Z"), data.OverlapOptions{}))
+	assert.Equal(t, data.RelationFinishedBy, service.Relate(until("2025-01-01T00:00:00Z"), createDateRange("2024-01-01T00:00:00Z", "2025-01-01T00:00:00Z"), data.OverlapOptions{}))
+	assert.Equal(t, data.RelationMeets, service.Relate(until("2025-01-01T00:00:00Z"), from("2025-01-01T00:00:00Z"), data.OverlapOptions{}))
+	assert.Equal(t, data.RelationOverlaps, service.Relate(until("2025-06-01T00:00:00Z"), from("2025-01-01T00:00:00Z"), data.OverlapOptions{}))
+	assert.Equal(t, data.RelationContains, service.Relate(data.DateRange{End: mustParseTime("2030-01-01T00:00:00Z")}, createDateRange("2025-01-01T00:00:00Z", "2025-02-01T00:00:00Z"), data.OverlapOptions{}))
+}
+
+func TestOverlapService_SetOperationsWithOpenEndedRanges(t *testing.T) {
+	service := newTestService()
+	opts := data.OverlapOptions{}
+
+	union := service.Union([]data.DateRange{from("2025-03-01T00:00:00Z"), createDateRange("2025-01-01T00:00:00Z", "2025-04-01T00:00:00Z")}, nil, opts)
+	assert.Equal(t, data.RangeSet{from("2025-01-01T00:00:00Z")}, union.Ranges)
+
+	complement := service.Complement([]data.DateRange{createDateRange("2025-01-01T00:00:00Z", "2025-02-01T00:00:00Z")}, from("2024-01-01T00:00:00Z"), opts)
+	assert.Equal(t, data.RangeSet{createDateRange("2024-01-01T00:00:00Z", "2025-01-01T00:00:00Z"), from("2025-02-01T00:00:00Z")}, complement.Ranges)
+
+	difference := service.Difference([]data.DateRange{createDateRange("2025-01-01T00:00:00Z", "2025-12-01T00:00:00Z")}, []data.DateRange{from("2025-06-01T00:00:00Z")}, opts)
+	assert.Equal(t, data.RangeSet{createDateRange("2025-01-01T00:00:00Z", "2025-06-01T00:00:00Z")}, difference.Ranges)
+
+	gaps := service.FindGaps(until("2025-01-01T00:00:00Z"), []data.DateRange{until("2024-01-01T00:00:00Z")}, data.GapOptions{MinGap: data.Duration(time.Hour)})
+	assert.Equal(t, data.RangeSet{createDateRange("2024-01-01T00:00:00Z", "2025-01-01T00:00:00Z")}, gaps.Gaps)
+
+	pairs := service.FindOverlaps([]data.IdentifiedRange{
+		{ID: "forever", Range: from("2020-01-01T00:00:00Z")},
+		{ID: "old", Range: until("2019-01-01T00:00:00Z")},
+		{ID: "later", Range: createDateRange("2030-01-01T00:00:00Z", "2031-01-01T00:00:00Z")},
+	}, opts).Pairs
+	require.Len(t, pairs, 1)
+	assert.Equal(t, "forever", pairs[0].FirstID)
+	assert.Equal(t, "later", pairs[0].SecondID)
+}
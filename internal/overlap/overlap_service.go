@@ -1,26 +1,124 @@
 package overlap
 
 import (
+	"github.com/keshu12345/overlap-avalara/config"
 	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/internal/calendar"
 	"github.com/keshu12345/overlap-avalara/logger"
 )
 
 // mockery --exported --name=OverlapService --case underscore --output ../../mocks/overlapservice
 type OverlapService interface {
-	Check(r1, r2 data.DateRange) bool
+	Check(r1, r2 data.DateRange, opts data.OverlapOptions) bool
+	Intersect(r1, r2 data.DateRange, opts data.OverlapOptions) data.OverlapResult
+	Relate(r1, r2 data.DateRange, opts data.OverlapOptions) data.Relation
+	FindOverlaps(ranges []data.IdentifiedRange, opts data.OverlapOptions) data.MultiOverlapResult
+	FindDimensionalOverlaps(records []data.DimensionalRecord, opts data.OverlapOptions) (data.DimensionalOverlapResult, error)
+	Join(left, right []data.IdentifiedRange, opts data.JoinOptions, emit func(data.JoinRow) error) (data.JoinSummary, error)
+	Concurrency(ranges []data.IdentifiedRange, opts data.ConcurrencyOptions) data.ConcurrencyResult
+	Union(a, b []data.DateRange, opts data.OverlapOptions) data.RangeSetResult
+	IntersectSets(a, b []data.DateRange, opts data.OverlapOptions) data.RangeSetResult
+	Difference(a, b []data.DateRange, opts data.OverlapOptions) data.RangeSetResult
+	SymmetricDifference(a, b []data.DateRange, opts data.OverlapOptions) data.RangeSetResult
+	Complement(set []data.DateRange, window data.DateRange, opts data.OverlapOptions) data.RangeSetResult
+	FindGaps(window data.DateRange, busy []data.DateRange, opts data.GapOptions) data.GapResult
+	FindSlots(busy map[string][]data.DateRange, duration data.Duration, window data.DateRange, opts data.SlotOptions) (data.SlotResult, error)
+	ResolveConflicts(ranges []data.ResolvableRange, opts data.ResolveOptions) data.ResolutionResult
+	Coverage(target data.DateRange, ranges []data.IdentifiedRange, opts data.OverlapOptions) data.CoverageResult
+	ValidateTaxRates(rows []data.TaxRateRow, window *data.DateRange, opts data.OverlapOptions) data.TaxRateReport
+	Prorate(period data.DateRange, rates []data.RatePeriod, opts data.ProrationOptions) (data.ProrationResult, error)
+	FilingPeriods(r data.DateRange, opts data.FilingOptions) (data.FilingPeriodResult, error)
+	Partition(records []data.PartitionRecord, opts data.PartitionOptions) data.PartitionResult
+	FindRecurringOverlaps(s1, s2 data.Schedule, opts data.RecurrenceOptions) (data.RecurringOverlapResult, error)
+	IntersectZoned(r1, r2 data.ZonedRange, opts data.ZoneOptions) (data.ZonedOverlapResult, error)
+	IntersectDates(r1, r2 data.CivilDateRange) data.CivilOverlapResult
+	IntersectMixed(r1, r2 data.MixedRange, opts data.MixedOptions) (data.ZonedOverlapResult, error)
+	BusinessOverlap(r1, r2 data.MixedRange, opts data.BusinessDayOptions) (data.BusinessOverlapResult, error)
+	Calendars() data.CalendarList
+	IntersectInts(r1, r2 data.IntRange, b data.Boundary) data.NumericOverlapResult[int64]
+	IntersectDecimals(r1, r2 data.DecimalRange, b data.Boundary) data.NumericOverlapResult[data.Decimal]
+	FindIntOverlaps(ranges []data.IdentifiedNumericRange[int64], b data.Boundary) data.NumericPairsResult[int64]
+	FindDecimalOverlaps(ranges []data.IdentifiedNumericRange[data.Decimal], b data.Boundary) data.NumericPairsResult[data.Decimal]
 }
 
 type overlapService struct {
-	Logger logger.Logger
+	Logger           logger.Logger
+	defaultBoundary  data.Boundary
+	maxOccurrences   int
+	maxFilingPeriods int
+	calendars        *calendar.Registry
 }
 
-func New(logger logger.Logger) OverlapService {
-	return &overlapService{
-		Logger: logger,
+// New builds the overlap service. cfg may be nil, in which case ranges are
+// treated as half-open [start,end) unless a request says otherwise, a
+// recurring series is expanded at most 10000 times and a range may span at
+// most 1200 filing periods. calendars may be nil too, leaving no holiday
+// calendars to pick from.
+func New(logger logger.Logger, cfg *config.Configuration, calendars *calendar.Registry) OverlapService {
+	os := &overlapService{
+		Logger:           logger,
+		calendars:        calendars,
+		defaultBoundary:  data.BoundaryClosedOpen,
+		maxOccurrences:   defaultMaxOccurrences,
+		maxFilingPeriods: defaultMaxFilingPeriods,
 	}
+	if cfg != nil && cfg.Overlap.DefaultBoundary != "" {
+		os.defaultBoundary = cfg.Overlap.DefaultBoundary
+	}
+	if cfg != nil && cfg.Overlap.MaxOccurrences > 0 {
+		os.maxOccurrences = cfg.Overlap.MaxOccurrences
+	}
+	if cfg != nil && cfg.Overlap.MaxFilingPeriods > 0 {
+		os.maxFilingPeriods = cfg.Overlap.MaxFilingPeriods
+	}
+	return os
 }
 
-func (os *overlapService) Check(r1, r2 data.DateRange) bool {
+func (os *overlapService) Check(r1, r2 data.DateRange, opts data.OverlapOptions) bool {
 	os.Logger.Info("Checking time range  with overlapservice")
-	return r1.Start.Before(r2.End) && r2.Start.Before(r1.End)
+	r1, r2 = pad(r1, opts), pad(r2, opts)
+	r, ok := intersection(r1, r2, os.boundary(opts))
+	return ok && longEnough(r.Duration(), r1, r2, opts.MinOverlap)
+}
+
+func (os *overlapService) Intersect(r1, r2 data.DateRange, opts data.OverlapOptions) data.OverlapResult {
+	os.Logger.Info("Computing time range intersection with overlapservice")
+	return intersectPadded(r1, r2, opts, os.boundary(opts))
+}
+
+// intersect describes the overlap of r1 and r2, which is no overlap at all
+// when it is shorter than min.
+func intersect(r1, r2 data.DateRange, boundary data.Boundary, min *data.MinOverlap) data.OverlapResult {
+	r, ok := intersection(r1, r2, boundary)
+	if !ok || !longEnough(r.Duration(), r1, r2, min) {
+		return data.OverlapResult{Boundary: boundary}
+	}
+
+	overlap := r.Duration()
+	return data.OverlapResult{
+		Overlap:        true,
+		Intersection:   &r,
+		Duration:       overlap,
+		Range1Coverage: coverage(overlap, r1),
+		Range2Coverage: coverage(overlap, r2),
+		Jaccard:        jaccard(overlap, r1, r2),
+		Boundary:       boundary,
+	}
+}
+
+// Relate classifies how r1 relates to r2 using Allen's interval algebra.
+// Missing bounds count as infinities, so two ranges without a start share it.
+// Neither padding nor boundary modes apply, as the relations are defined on
+// the endpoints themselves.
+func (os *overlapService) Relate(r1, r2 data.DateRange, opts data.OverlapOptions) data.Relation {
+	os.Logger.Info("Classifying time range relation with overlapservice")
+	return times.Relate(toInterval(r1), toInterval(r2))
+}
+
+// boundary resolves the boundary mode of a request, falling back to the server default.
+func (os *overlapService) boundary(opts data.OverlapOptions) data.Boundary {
+	if opts.Boundary != "" {
+		return opts.Boundary
+	}
+	return os.defaultBoundary
 }
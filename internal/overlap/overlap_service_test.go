@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/keshu12345/overlap-avalara/config"
 	"github.com/keshu12345/overlap-avalara/data"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -255,14 +256,14 @@ func TestOverlapService_Check(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			mockLogger := &MockLogger{}
-			service := New(mockLogger)
+			service := New(mockLogger, nil, nil)
 
 			mockLogger.On("Info", mock.Anything).Return()
 
 			range1 := createDateRange(tc.range1Start, tc.range1End)
 			range2 := createDateRange(tc.range2Start, tc.range2End)
 
-			result := service.Check(range1, range2)
+			result := service.Check(range1, range2, data.OverlapOptions{})
 
 			assert.Equal(t, tc.expectedResult, result, tc.description)
 
@@ -277,7 +278,7 @@ func TestOverlapService_New(t *testing.T) {
 		mockLogger := &MockLogger{}
 
 		// Execute
-		service := New(mockLogger)
+		service := New(mockLogger, nil, nil)
 
 		// Assertions
 		assert.NotNil(t, service)
@@ -290,27 +291,195 @@ func TestOverlapService_New(t *testing.T) {
 func BenchmarkOverlapService_Check_Overlapping(b *testing.B) {
 	mockLogger := &MockLogger{}
 	mockLogger.On("Info", mock.Anything).Return()
-	service := New(mockLogger)
+	service := New(mockLogger, nil, nil)
 
 	range1 := createDateRange("2025-07-01T10:00:00Z", "2025-07-01T12:00:00Z")
 	range2 := createDateRange("2025-07-01T11:00:00Z", "2025-07-01T13:00:00Z")
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		service.Check(range1, range2)
+		service.Check(range1, range2, data.OverlapOptions{})
 	}
 }
 
 func BenchmarkOverlapService_Check_NonOverlapping(b *testing.B) {
 	mockLogger := &MockLogger{}
 	mockLogger.On("Info", mock.Anything).Return()
-	service := New(mockLogger)
+	service := New(mockLogger, nil, nil)
 
 	range1 := createDateRange("2025-07-01T10:00:00Z", "2025-07-01T11:00:00Z")
 	range2 := createDateRange("2025-07-01T12:00:00Z", "2025-07-01T13:00:00Z")
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		service.Check(range1, range2)
+		service.Check(range1, range2, data.OverlapOptions{})
 	}
 }
+
+func TestOverlapService_Intersect(t *testing.T) {
+	testCases := []struct {
+		name             string
+		range1           data.DateRange
+		range2           data.DateRange
+		expectedOverlap  bool
+		expectedRange    *data.DateRange
+		expectedDuration time.Duration
+		expectedCover1   float64
+		expectedCover2   float64
+		expectedJaccard  float64
+	}{
+		{
+			name:             "Partial Overlap",
+			range1:           createDateRange("2025-07-01T10:00:00Z", "2025-07-01T12:00:00Z"),
+			range2:           createDateRange("2025-07-01T11:00:00Z", "2025-07-01T15:00:00Z"),
+			expectedOverlap:  true,
+			expectedRange:    &data.DateRange{Start: mustParseTime("2025-07-01T11:00:00Z"), End: mustParseTime("2025-07-01T12:00:00Z")},
+			expectedDuration: time.Hour,
+			expectedCover1:   0.5,
+			expectedCover2:   0.25,
+			expectedJaccard:  0.2,
+		},
+		{
+			name:             "Range1 Contains Range2",
+			range1:           createDateRange("2025-07-01T08:00:00Z", "2025-07-01T16:00:00Z"),
+			range2:           createDateRange("2025-07-01T10:00:00Z", "2025-07-01T12:00:00Z"),
+			expectedOverlap:  true,
+			expectedRange:    &data.DateRange{Start: mustParseTime("2025-07-01T10:00:00Z"), End: mustParseTime("2025-07-01T12:00:00Z")},
+			expectedDuration: 2 * time.Hour,
+			expectedCover1:   0.25,
+			expectedCover2:   1,
+			expectedJaccard:  0.25,
+		},
+		{
+			name:             "Identical Ranges",
+			range1:           createDateRange("2025-07-01T10:00:00Z", "2025-07-01T12:00:00Z"),
+			range2:           createDateRange("2025-07-01T10:00:00Z", "2025-07-01T12:00:00Z"),
+			expectedOverlap:  true,
+			expectedRange:    &data.DateRange{Start: mustParseTime("2025-07-01T10:00:00Z"), End: mustParseTime("2025-07-01T12:00:00Z")},
+			expectedDuration: 2 * time.Hour,
+			expectedCover1:   1,
+			expectedCover2:   1,
+			expectedJaccard:  1,
+		},
+		{
+			name:            "Adjacent Ranges",
+			range1:          createDateRange("2025-07-01T10:00:00Z", "2025-07-01T11:00:00Z"),
+			range2:          createDateRange("2025-07-01T11:00:00Z", "2025-07-01T12:00:00Z"),
+			expectedOverlap: false,
+		},
+		{
+			name:            "Gap Between",
+			range1:          createDateRange("2025-07-01T10:00:00Z", "2025-07-01T11:00:00Z"),
+			range2:          createDateRange("2025-07-01T13:00:00Z", "2025-07-01T14:00:00Z"),
+			expectedOverlap: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockLogger := &MockLogger{}
+			mockLogger.On("Info", mock.Anything).Return()
+			service := New(mockLogger, nil, nil)
+
+			result := service.Intersect(tc.range1, tc.range2, data.OverlapOptions{})
+
+			assert.Equal(t, tc.expectedOverlap, result.Overlap)
+			assert.Equal(t, tc.expectedRange, result.Intersection)
+			assert.Equal(t, data.Duration(tc.expectedDuration), result.Duration)
+			assert.InDelta(t, tc.expectedCover1, result.Range1Coverage, 1e-9)
+			assert.InDelta(t, tc.expectedCover2, result.Range2Coverage, 1e-9)
+			assert.InDelta(t, tc.expectedJaccard, result.Jaccard, 1e-9)
+			assert.Equal(t, service.Check(tc.range1, tc.range2, data.OverlapOptions{}), result.Overlap, "Intersect must agree with Check")
+		})
+	}
+}
+
+func TestOverlapService_Relate(t *testing.T) {
+	base := createDateRange("2025-07-01T10:00:00Z", "2025-07-01T12:00:00Z")
+
+	testCases := []struct {
+		name     string
+		other    data.DateRange
+		expected data.Relation
+	}{
+		{"Before", createDateRange("2025-07-01T13:00:00Z", "2025-07-01T14:00:00Z"), data.RelationBefore},
+		{"Meets", createDateRange("2025-07-01T12:00:00Z", "2025-07-01T14:00:00Z"), data.RelationMeets},
+		{"Overlaps", createDateRange("2025-07-01T11:00:00Z", "2025-07-01T14:00:00Z"), data.RelationOverlaps},
+		{"Starts", createDateRange("2025-07-01T10:00:00Z", "2025-07-01T14:00:00Z"), data.RelationStarts},
+		{"During", createDateRange("2025-07-01T09:00:00Z", "2025-07-01T14:00:00Z"), data.RelationDuring},
+		{"Finishes", createDateRange("2025-07-01T08:00:00Z", "2025-07-01T12:00:00Z"), data.RelationFinishes},
+		{"Equals", createDateRange("2025-07-01T10:00:00Z", "2025-07-01T12:00:00Z"), data.RelationEquals},
+		{"After", createDateRange("2025-07-01T07:00:00Z", "2025-07-01T09:00:00Z"), data.RelationAfter},
+		{"Met By", createDateRange("2025-07-01T08:00:00Z", "2025-07-01T10:00:00Z"), data.RelationMetBy},
+		{"Overlapped By", createDateRange("2025-07-01T09:00:00Z", "2025-07-01T11:00:00Z"), data.RelationOverlappedBy},
+		{"Started By", createDateRange("2025-07-01T10:00:00Z", "2025-07-01T11:00:00Z"), data.RelationStartedBy},
+		{"Contains", createDateRange("2025-07-01T10:30:00Z", "2025-07-01T11:30:00Z"), data.RelationContains},
+		{"Finished By", createDateRange("2025-07-01T11:00:00Z", "2025-07-01T12:00:00Z"), data.RelationFinishedBy},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockLogger := &MockLogger{}
+			mockLogger.On("Info", mock.Anything).Return()
+			service := New(mockLogger, nil, nil)
+
+			assert.Equal(t, tc.expected, service.Relate(base, tc.other, data.OverlapOptions{}))
+			assert.Equal(t, tc.expected.Inverse(), service.Relate(tc.other, base, data.OverlapOptions{}), "swapping the ranges should give the inverse relation")
+		})
+	}
+}
+
+func TestOverlapService_CheckBoundaryModes(t *testing.T) {
+	touching1 := createDateRange("2025-07-01T10:00:00Z", "2025-07-01T11:00:00Z")
+	touching2 := createDateRange("2025-07-01T11:00:00Z", "2025-07-01T12:00:00Z")
+	instant := createDateRange("2025-07-01T11:00:00Z", "2025-07-01T11:00:00Z")
+
+	testCases := []struct {
+		name     string
+		boundary data.Boundary
+		range1   data.DateRange
+		range2   data.DateRange
+		expected bool
+	}{
+		{"Closed Touching Ranges Overlap", data.BoundaryClosed, touching1, touching2, true},
+		{"Half-Open Touching Ranges Do Not Overlap", data.BoundaryClosedOpen, touching1, touching2, false},
+		{"Open-Closed Touching Ranges Do Not Overlap", data.BoundaryOpenClosed, touching1, touching2, false},
+		{"Open Touching Ranges Do Not Overlap", data.BoundaryOpen, touching1, touching2, false},
+		{"Closed Instant Inside Range", data.BoundaryClosed, instant, touching2, true},
+		{"Half-Open Instant Is Empty", data.BoundaryClosedOpen, instant, touching2, false},
+		{"Open Overlapping Ranges", data.BoundaryOpen, createDateRange("2025-07-01T10:00:00Z", "2025-07-01T12:00:00Z"), touching2, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockLogger := &MockLogger{}
+			mockLogger.On("Info", mock.Anything).Return()
+			service := New(mockLogger, nil, nil)
+
+			opts := data.OverlapOptions{Boundary: tc.boundary}
+			assert.Equal(t, tc.expected, service.Check(tc.range1, tc.range2, opts))
+
+			result := service.Intersect(tc.range1, tc.range2, opts)
+			assert.Equal(t, tc.expected, result.Overlap)
+			assert.Equal(t, tc.boundary, result.Boundary)
+		})
+	}
+}
+
+func TestOverlapService_DefaultBoundaryFromConfig(t *testing.T) {
+	mockLogger := &MockLogger{}
+	mockLogger.On("Info", mock.Anything).Return()
+
+	cfg := &config.Configuration{Overlap: config.Overlap{DefaultBoundary: data.BoundaryClosed}}
+	service := New(mockLogger, cfg, nil)
+
+	range1 := createDateRange("2025-07-01T10:00:00Z", "2025-07-01T11:00:00Z")
+	range2 := createDateRange("2025-07-01T11:00:00Z", "2025-07-01T12:00:00Z")
+
+	assert.True(t, service.Check(range1, range2, data.OverlapOptions{}), "configured closed default should count touching ranges")
+	assert.False(t, service.Check(range1, range2, data.OverlapOptions{Boundary: data.BoundaryClosedOpen}), "request boundary should win over the default")
+
+	result := service.Intersect(range1, range2, data.OverlapOptions{})
+	assert.Equal(t, data.BoundaryClosed, result.Boundary)
+	assert.Equal(t, data.Duration(0), result.Duration)
+}
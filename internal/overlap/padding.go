@@ -0,0 +1,90 @@
+package overlap
+
+import (
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+)
+
+// pad widens r by its own padding, or by the padding of the request when r
+// has none. Missing bounds stay missing. The result carries no padding, so it
+// is never widened twice.
+func pad(r data.DateRange, opts data.OverlapOptions) data.DateRange {
+	p := r.Padding
+	if p.IsZero() {
+		p = opts.Padding
+	}
+	padded := data.DateRange{Start: r.Start, End: r.End}
+	if padded.HasStart() {
+		padded.Start = padded.Start.Add(-time.Duration(p.Before))
+	}
+	if padded.HasEnd() {
+		padded.End = padded.End.Add(time.Duration(p.After))
+	}
+	return padded
+}
+
+func padAll(ranges []data.DateRange, opts data.OverlapOptions) []data.DateRange {
+	padded := make([]data.DateRange, len(ranges))
+	for i, r := range ranges {
+		padded[i] = pad(r, opts)
+	}
+	return padded
+}
+
+func padIdentified(ranges []data.IdentifiedRange, opts data.OverlapOptions) []data.DateRange {
+	padded := make([]data.DateRange, len(ranges))
+	for i, r := range ranges {
+		padded[i] = pad(r.Range, opts)
+	}
+	return padded
+}
+
+// padEach pads every range, keeping its ID.
+func padEach(ranges []data.IdentifiedRange, opts data.OverlapOptions) []data.IdentifiedRange {
+	padded := make([]data.IdentifiedRange, len(ranges))
+	for i, r := range ranges {
+		padded[i] = data.IdentifiedRange{ID: r.ID, Range: pad(r.Range, opts)}
+	}
+	return padded
+}
+
+// widen grows window by the padding of the request, the other way round, so
+// that it takes in every range whose padding reaches into it.
+func widen(window data.DateRange, opts data.OverlapOptions) data.DateRange {
+	if window.HasStart() {
+		window.Start = window.Start.Add(-time.Duration(opts.Padding.After))
+	}
+	if window.HasEnd() {
+		window.End = window.End.Add(time.Duration(opts.Padding.Before))
+	}
+	return window
+}
+
+func isPadded(r data.DateRange, opts data.OverlapOptions) bool {
+	return !r.Padding.IsZero() || !opts.Padding.IsZero()
+}
+
+// rawIntersection returns the overlap of r1 and r2 as given, for showing next
+// to their padded conflict window. It is nil when no padding applies, since
+// the conflict window is then the raw overlap, or when the ranges only clash
+// because of their padding.
+func rawIntersection(r1, r2 data.DateRange, opts data.OverlapOptions, b data.Boundary) *data.DateRange {
+	if !isPadded(r1, opts) && !isPadded(r2, opts) {
+		return nil
+	}
+	r, ok := intersection(r1, r2, b)
+	if !ok {
+		return nil
+	}
+	return &r
+}
+
+// intersectPadded is intersect on the padded ranges, with the raw overlap added.
+func intersectPadded(r1, r2 data.DateRange, opts data.OverlapOptions, b data.Boundary) data.OverlapResult {
+	result := intersect(pad(r1, opts), pad(r2, opts), b, opts.MinOverlap)
+	if result.Overlap {
+		result.RawIntersection = rawIntersection(r1, r2, opts, b)
+	}
+	return result
+}
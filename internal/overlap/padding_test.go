@@ -0,0 +1,182 @@
+package overlap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func padding(before, after time.Duration) data.Padding {
+	return data.Padding{Before: data.Duration(before), After: data.Duration(after)}
+}
+
+func padded(r data.DateRange, p data.Padding) data.DateRange {
+	r.Padding = p
+	return r
+}
+
+func TestOverlapService_CheckWithPadding(t *testing.T) {
+	morning := createDateRange("2025-07-01T09:00:00Z", "2025-07-01T10:00:00Z")
+	nearMiss := createDateRange("2025-07-01T10:10:00Z", "2025-07-01T11:00:00Z")
+
+	testCases := []struct {
+		name     string
+		range1   data.DateRange
+		range2   data.DateRange
+		opts     data.OverlapOptions
+		expected bool
+	}{
+		{"Near Miss Without Padding", morning, nearMiss, data.OverlapOptions{}, false},
+		{"Request Padding Covers The Gap", morning, nearMiss, data.OverlapOptions{Padding: padding(0, 15*time.Minute)}, true},
+		{"Request Padding Too Short", morning, nearMiss, data.OverlapOptions{Padding: padding(5*time.Minute, 0)}, false},
+		{"Paddings Add Up", morning, nearMiss, data.OverlapOptions{Padding: padding(6*time.Minute, 6*time.Minute)}, true},
+		{"Range Padding Overrides Request", padded(morning, padding(0, 5*time.Minute)), nearMiss, data.OverlapOptions{Padding: padding(0, 15*time.Minute)}, false},
+		{"Range Padding Alone", morning, padded(nearMiss, padding(15*time.Minute, 0)), data.OverlapOptions{}, true},
+		{"Padded Ranges Only Touch", morning, nearMiss, data.OverlapOptions{Padding: padding(0, 10*time.Minute)}, false},
+		{"Padded Ranges Touch With Closed Boundary", morning, nearMiss, data.OverlapOptions{Padding: padding(0, 10*time.Minute), Boundary: data.BoundaryClosed}, true},
+		{"Padding Keeps Open End Open", from("2025-07-01T12:00:00Z"), nearMiss, data.OverlapOptions{Padding: padding(2*time.Hour, 0)}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := newTestService()
+
+			assert.Equal(t, tc.expected, service.Check(tc.range1, tc.range2, tc.opts))
+			assert.Equal(t, tc.expected, service.Check(tc.range2, tc.range1, tc.opts))
+		})
+	}
+}
+
+func TestOverlapService_IntersectWithPadding(t *testing.T) {
+	service := newTestService()
+	opts := data.OverlapOptions{Padding: padding(15*time.Minute, 15*time.Minute)}
+
+	t.Run("Near Miss Has No Raw Intersection", func(t *testing.T) {
+		result := service.Intersect(
+			createDateRange("2025-07-01T09:00:00Z", "2025-07-01T10:00:00Z"),
+			createDateRange("2025-07-01T10:10:00Z", "2025-07-01T11:00:00Z"),
+			opts,
+		)
+
+		require.True(t, result.Overlap)
+		assert.Equal(t, createDateRange("2025-07-01T09:55:00Z", "2025-07-01T10:15:00Z"), *result.Intersection)
+		assert.Equal(t, data.Duration(20*time.Minute), result.Duration)
+		assert.Nil(t, result.RawIntersection)
+	})
+
+	t.Run("Overlap Shows Raw And Padded Windows", func(t *testing.T) {
+		result := service.Intersect(
+			createDateRange("2025-07-01T09:00:00Z", "2025-07-01T10:00:00Z"),
+			createDateRange("2025-07-01T09:30:00Z", "2025-07-01T11:00:00Z"),
+			opts,
+		)
+
+		require.True(t, result.Overlap)
+		assert.Equal(t, createDateRange("2025-07-01T09:15:00Z", "2025-07-01T10:15:00Z"), *result.Intersection)
+		require.NotNil(t, result.RawIntersection)
+		assert.Equal(t, createDateRange("2025-07-01T09:30:00Z", "2025-07-01T10:00:00Z"), *result.RawIntersection)
+	})
+
+	t.Run("No Raw Intersection Without Padding", func(t *testing.T) {
+		result := service.Intersect(
+			createDateRange("2025-07-01T09:00:00Z", "2025-07-01T10:00:00Z"),
+			createDateRange("2025-07-01T09:30:00Z", "2025-07-01T11:00:00Z"),
+			data.OverlapOptions{},
+		)
+
+		require.True(t, result.Overlap)
+		assert.Nil(t, result.RawIntersection)
+	})
+}
+
+func TestOverlapService_RelateIgnoresPadding(t *testing.T) {
+	service := newTestService()
+	r1 := createDateRange("2025-07-01T09:00:00Z", "2025-07-01T10:00:00Z")
+	r2 := createDateRange("2025-07-01T10:15:00Z", "2025-07-01T11:00:00Z")
+
+	assert.Equal(t, data.RelationBefore, service.Relate(r1, r2, data.OverlapOptions{}))
+	assert.Equal(t, data.RelationBefore, service.Relate(padded(r1, padding(0, 15*time.Minute)), r2, data.OverlapOptions{}))
+	assert.Equal(t, data.RelationBefore, service.Relate(r1, r2, data.OverlapOptions{Padding: padding(0, 30*time.Minute)}))
+}
+
+func TestOverlapService_FindOverlapsWithPadding(t *testing.T) {
+	service := newTestService()
+	ranges := []data.IdentifiedRange{
+		identified("a", "2025-07-01T09:00:00Z", "2025-07-01T10:00:00Z"),
+		identified("b", "2025-07-01T10:10:00Z", "2025-07-01T11:00:00Z"),
+		identified("c", "2025-07-01T10:30:00Z", "2025-07-01T12:00:00Z"),
+	}
+
+	result := service.FindOverlaps(ranges, data.OverlapOptions{Padding: padding(0, 15*time.Minute)})
+
+	require.Len(t, result.Pairs, 2)
+	assert.Equal(t, "a", result.Pairs[0].FirstID)
+	assert.Equal(t, "b", result.Pairs[0].SecondID)
+	assert.Equal(t, createDateRange("2025-07-01T10:10:00Z", "2025-07-01T10:15:00Z"), result.Pairs[0].Intersection)
+	assert.Nil(t, result.Pairs[0].RawIntersection)
+
+	assert.Equal(t, "b", result.Pairs[1].FirstID)
+	assert.Equal(t, "c", result.Pairs[1].SecondID)
+	assert.Equal(t, createDateRange("2025-07-01T10:30:00Z", "2025-07-01T11:15:00Z"), result.Pairs[1].Intersection)
+	require.NotNil(t, result.Pairs[1].RawIntersection)
+	assert.Equal(t, createDateRange("2025-07-01T10:30:00Z", "2025-07-01T11:00:00Z"), *result.Pairs[1].RawIntersection)
+}
+
+func TestOverlapService_SetOperationsIgnorePadding(t *testing.T) {
+	service := newTestService()
+	opts := data.OverlapOptions{Padding: padding(0, 30*time.Minute)}
+	busy := []data.DateRange{hours(9, 10), hours(11, 12)}
+
+	union := service.Union(busy, nil, opts)
+	assert.Equal(t, data.RangeSet{hours(9, 10), hours(11, 12)}, union.Ranges)
+
+	complement := service.Complement(busy, hours(8, 13), opts)
+	assert.Equal(t, data.RangeSet{hours(8, 9), hours(10, 11), hours(12, 13)}, complement.Ranges)
+
+	// Gaps are free time, so the padding around busy ranges is not free.
+
+	gaps := service.FindGaps(hours(8, 13), busy, data.GapOptions{MinGap: data.Duration(45 * time.Minute), OverlapOptions: opts})
+	assert.Equal(t, data.RangeSet{hours(8, 9)}, gaps.Gaps)
+}
+
+func TestOverlapService_FindRecurringOverlapsWithPadding(t *testing.T) {
+	service := newTestService()
+	mondays := recurring("2025-07-07T09:00:00Z", time.Hour, "FREQ=WEEKLY;BYDAY=MO;COUNT=4")
+	afterwards := recurring("2025-07-07T10:10:00Z", time.Hour, "FREQ=WEEKLY;BYDAY=MO;COUNT=2")
+
+	result, err := service.FindRecurringOverlaps(mondays, afterwards, data.RecurrenceOptions{})
+	require.NoError(t, err)
+	assert.False(t, result.Overlap)
+
+	opts := data.RecurrenceOptions{OverlapOptions: data.OverlapOptions{Padding: padding(0, 15*time.Minute)}}
+	result, err = service.FindRecurringOverlaps(mondays, afterwards, opts)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2025-07-07T10:10:00Z", "2025-07-14T10:10:00Z"}, clashStarts(result))
+	assert.Equal(t, createDateRange("2025-07-07T09:00:00Z", "2025-07-07T10:00:00Z"), result.Clashes[0].First)
+	assert.Nil(t, result.Clashes[0].RawIntersection)
+
+	t.Run("Padding Reaches Into The Window", func(t *testing.T) {
+		window := createDateRange("2025-07-21T10:05:00Z", "2025-07-21T10:20:00Z")
+		opts := data.RecurrenceOptions{Window: &window, OverlapOptions: data.OverlapOptions{Padding: padding(15*time.Minute, 15*time.Minute)}}
+		shifted := recurring("2025-07-21T10:20:00Z", time.Hour, "")
+
+		result, err := service.FindRecurringOverlaps(mondays, shifted, opts)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"2025-07-21T10:05:00Z"}, clashStarts(result))
+	})
+}
+
+func TestOverlapService_IntersectMixedWithPadding(t *testing.T) {
+	service := newTestService()
+	r1 := mixed(padded(createDateRange("2025-07-01T20:00:00Z", "2025-07-01T22:00:00Z"), padding(0, 3*time.Hour)))
+
+	result, err := service.IntersectMixed(r1, mixedDays("2025-07-02", "2025-07-02"), data.MixedOptions{Zone: "UTC"})
+	require.NoError(t, err)
+	require.True(t, result.Overlap)
+	assert.True(t, mustParseTime("2025-07-02T00:00:00Z").Equal(result.Intersection.Start))
+	assert.True(t, mustParseTime("2025-07-02T01:00:00Z").Equal(result.Intersection.End))
+	assert.Nil(t, result.RawIntersection)
+}
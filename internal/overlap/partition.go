@@ -0,0 +1,122 @@
+package overlap
+
+import (
+	"cmp"
+	"container/heap"
+
+	"github.com/keshu12345/overlap-avalara/data"
+)
+
+// Partition splits the occupancy timeline of records into segments and keeps,
+// in each, the records that the policy applies. The segments never share an
+// instant, so under [] and () they carry their own ends where those differ
+// from the boundary. Neighbouring segments left with the same records are
+// merged again when no instant lies between them. Ties under latest_start and
+// highest_priority go to the record listed last. The segments cut the records
+// as given, so padding does not apply.
+func (os *overlapService) Partition(records []data.PartitionRecord, opts data.PartitionOptions) data.PartitionResult {
+	os.Logger.Info("Partitioning effective-dated records with overlapservice")
+	boundary := os.boundary(opts.OverlapOptions)
+	policy := opts.Policy
+	if policy == "" {
+		policy = data.PartitionKeepAll
+	}
+
+	ranges := make([]data.IdentifiedRange, len(records))
+	for i, r := range records {
+		ranges[i] = data.IdentifiedRange{ID: r.ID, Range: r.Range}
+	}
+
+	active := make([]data.OccupancySegment, 0)
+	for _, s := range occupancyTimeline(ranges, boundary) {
+		if s.Count > 0 {
+			active = append(active, s)
+		}
+	}
+	if policy == data.PartitionKeepAll {
+		withIndexes(ranges, active, boundary)
+	} else {
+		withWinners(records, ranges, active, policy, boundary)
+	}
+
+	segments := make([]data.OccupancySegment, 0, len(active))
+	for _, s := range active {
+		if n := len(segments); n > 0 && sameIndexes(segments[n-1].Indexes, s.Indexes) {
+			if r, ok := adjoin(segments[n-1].Range, s.Range, boundary); ok {
+				segments[n-1].Range = r
+				continue
+			}
+		}
+		segments = append(segments, s)
+	}
+	return data.PartitionResult{Segments: segments, Policy: policy, Boundary: boundary}
+}
+
+// withWinners sets each of segments, taken in time order from the timeline
+// of ranges, to the one record that the policy applies there. The records
+// active are kept in a heap with the winner on top, and those that have ended
+// are only dropped once they reach it.
+func withWinners(records []data.PartitionRecord, ranges []data.IdentifiedRange, segments []data.OccupancySegment, policy data.PartitionPolicy, boundary data.Boundary) {
+	sweep := newCoverSweep(ranges, boundary)
+	candidates := &candidateHeap{records: records, policy: policy}
+	for k := range segments {
+		s := &segments[k]
+		sweep.advance(s.Range, func(i int) { heap.Push(candidates, i) }, func(int) {})
+		for sweep.gone[candidates.indexes[0]] {
+			heap.Pop(candidates)
+		}
+
+		winner := candidates.indexes[0]
+		s.Indexes, s.Count, s.IDs = []int{winner}, 1, nil
+		if id := records[winner].ID; id != "" {
+			s.IDs = []string{id}
+		}
+	}
+}
+
+// candidateHeap orders the indexes of records with the one the policy
+// applies first.
+type candidateHeap struct {
+	records []data.PartitionRecord
+	policy  data.PartitionPolicy
+	indexes []int
+}
+
+func (h *candidateHeap) Len() int      { return len(h.indexes) }
+func (h *candidateHeap) Swap(i, j int) { h.indexes[i], h.indexes[j] = h.indexes[j], h.indexes[i] }
+func (h *candidateHeap) Push(x any)    { h.indexes = append(h.indexes, x.(int)) }
+
+func (h *candidateHeap) Pop() any {
+	n := len(h.indexes)
+	x := h.indexes[n-1]
+	h.indexes = h.indexes[:n-1]
+	return x
+}
+
+// Less ranks a ahead of b when the policy prefers it, ties going to the
+// record listed last.
+func (h *candidateHeap) Less(i, j int) bool {
+	a, b := h.indexes[i], h.indexes[j]
+	c := 0
+	switch h.policy {
+	case data.PartitionLatestStart:
+		c = compareStarts(h.records[a].Range.Start, h.records[b].Range.Start)
+	case data.PartitionHighestPriority:
+		c = cmp.Compare(h.records[a].Priority, h.records[b].Priority)
+	}
+	if c != 0 {
+		return c > 0
+	}
+	return a > b
+}
+
+// adjoin returns prev and next as one range when next starts at the edge
+// where prev ends, so no instant lies between them.
+func adjoin(prev, next data.DateRange, b data.Boundary) (data.DateRange, bool) {
+	start, end := edges(prev, b)
+	from, to := edges(next, b)
+	if !prev.HasEnd() || !next.HasStart() || compareEdges(end, from) != 0 {
+		return data.DateRange{}, false
+	}
+	return fromEdges(start, to, b), true
+}
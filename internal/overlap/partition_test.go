@@ -0,0 +1,179 @@
+package overlap
+
+import (
+	"time"
+
+	"testing"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOverlapService_Partition(t *testing.T) {
+	records := []data.PartitionRecord{
+		{ID: "base", Range: hours(8, 16), Priority: 2},
+		{ID: "promo", Range: hours(10, 12), Priority: 1},
+		{ID: "fix", Range: hours(11, 13), Priority: 3},
+		{ID: "late", Range: hours(18, 20)},
+	}
+
+	testCases := []struct {
+		policy   data.PartitionPolicy
+		expected []data.OccupancySegment
+	}{
+		{
+			policy: data.PartitionKeepAll,
+			expected: []data.OccupancySegment{
+				segment(hours(8, 10), []int{0}, "base"),
+				segment(hours(10, 11), []int{0, 1}, "base", "promo"),
+				segment(hours(11, 12), []int{0, 1, 2}, "base", "promo", "fix"),
+				segment(hours(12, 13), []int{0, 2}, "base", "fix"),
+				segment(hours(13, 16), []int{0}, "base"),
+				segment(hours(18, 20), []int{3}, "late"),
+			},
+		},
+		{
+			policy: data.PartitionLatestStart,
+			expected: []data.OccupancySegment{
+				segment(hours(8, 10), []int{0}, "base"),
+				segment(hours(10, 11), []int{1}, "promo"),
+				segment(hours(11, 13), []int{2}, "fix"),
+				segment(hours(13, 16), []int{0}, "base"),
+				segment(hours(18, 20), []int{3}, "late"),
+			},
+		},
+		{
+			policy: data.PartitionHighestPriority,
+			expected: []data.OccupancySegment{
+				segment(hours(8, 11), []int{0}, "base"),
+				segment(hours(11, 13), []int{2}, "fix"),
+				segment(hours(13, 16), []int{0}, "base"),
+				segment(hours(18, 20), []int{3}, "late"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.policy), func(t *testing.T) {
+			service := newTestService()
+
+			result := service.Partition(records, data.PartitionOptions{Policy: tc.policy})
+
+			assert.Equal(t, tc.expected, result.Segments)
+			assert.Equal(t, tc.policy, result.Policy)
+			assert.Equal(t, data.BoundaryClosedOpen, result.Boundary)
+		})
+	}
+}
+
+func TestOverlapService_PartitionBoundaryModes(t *testing.T) {
+	records := []data.PartitionRecord{
+		{ID: "a", Range: hours(1, 10), Priority: 2},
+		{ID: "b", Range: hours(4, 6), Priority: 1},
+	}
+
+	testCases := []struct {
+		name     string
+		boundary data.Boundary
+		policy   data.PartitionPolicy
+		expected []data.OccupancySegment
+	}{
+		{
+			name:     "Closed",
+			boundary: data.BoundaryClosed,
+			policy:   data.PartitionKeepAll,
+			expected: []data.OccupancySegment{
+				segment(bounded(hours(1, 4), data.BoundaryClosedOpen), []int{0}, "a"),
+				segment(hours(4, 6), []int{0, 1}, "a", "b"),
+				segment(bounded(hours(6, 10), data.BoundaryOpenClosed), []int{0}, "a"),
+			},
+		},
+		{
+			name:     "Open",
+			boundary: data.BoundaryOpen,
+			policy:   data.PartitionKeepAll,
+			expected: []data.OccupancySegment{
+				segment(bounded(hours(1, 4), data.BoundaryOpenClosed), []int{0}, "a"),
+				segment(hours(4, 6), []int{0, 1}, "a", "b"),
+				segment(bounded(hours(6, 10), data.BoundaryClosedOpen), []int{0}, "a"),
+			},
+		},
+		{
+			name:     "Closed Latest Start",
+			boundary: data.BoundaryClosed,
+			policy:   data.PartitionLatestStart,
+			expected: []data.OccupancySegment{
+				segment(bounded(hours(1, 4), data.BoundaryClosedOpen), []int{0}, "a"),
+				segment(hours(4, 6), []int{1}, "b"),
+				segment(bounded(hours(6, 10), data.BoundaryOpenClosed), []int{0}, "a"),
+			},
+		},
+		{
+			name:     "Closed Segments Merge Back",
+			boundary: data.BoundaryClosed,
+			policy:   data.PartitionHighestPriority,
+			expected: []data.OccupancySegment{segment(hours(1, 10), []int{0}, "a")},
+		},
+		{
+			name:     "Open Segments Merge Back",
+			boundary: data.BoundaryOpen,
+			policy:   data.PartitionHighestPriority,
+			expected: []data.OccupancySegment{segment(hours(1, 10), []int{0}, "a")},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := newTestService()
+
+			result := service.Partition(records, data.PartitionOptions{Policy: tc.policy, OverlapOptions: data.OverlapOptions{Boundary: tc.boundary}})
+
+			assert.Equal(t, tc.expected, result.Segments, "every instant a record holds lies in exactly one segment")
+			assert.Equal(t, tc.boundary, result.Boundary)
+		})
+	}
+}
+
+func TestOverlapService_PartitionDefaultsAndTies(t *testing.T) {
+	service := newTestService()
+
+	t.Run("Keep All By Default", func(t *testing.T) {
+		result := service.Partition([]data.PartitionRecord{{Range: hours(8, 10)}}, data.PartitionOptions{})
+
+		assert.Equal(t, data.PartitionKeepAll, result.Policy)
+		assert.Equal(t, []data.OccupancySegment{segment(hours(8, 10), []int{0})}, result.Segments)
+	})
+
+	t.Run("Padding Does Not Move The Segments", func(t *testing.T) {
+		records := []data.PartitionRecord{{Range: hours(8, 10)}, {Range: hours(10, 12)}}
+		opts := data.PartitionOptions{OverlapOptions: data.OverlapOptions{Padding: padding(time.Hour, time.Hour)}}
+
+		result := service.Partition(records, opts)
+		assert.Equal(t, []data.OccupancySegment{segment(hours(8, 10), []int{0}), segment(hours(10, 12), []int{1})}, result.Segments)
+	})
+
+	t.Run("Ties Go To The Record Listed Last", func(t *testing.T) {
+		records := []data.PartitionRecord{
+			{ID: "first", Range: hours(8, 10), Priority: 1},
+			{ID: "second", Range: hours(8, 10), Priority: 1},
+		}
+
+		for _, policy := range []data.PartitionPolicy{data.PartitionLatestStart, data.PartitionHighestPriority} {
+			result := service.Partition(records, data.PartitionOptions{Policy: policy})
+			assert.Equal(t, []data.OccupancySegment{segment(hours(8, 10), []int{1}, "second")}, result.Segments, policy)
+		}
+	})
+
+	t.Run("Open-Ended Record Starts Earliest", func(t *testing.T) {
+		records := []data.PartitionRecord{
+			{ID: "current", Range: hours(8, 10)},
+			{ID: "legacy", Range: until("2025-07-01T09:00:00Z")},
+		}
+
+		result := service.Partition(records, data.PartitionOptions{Policy: data.PartitionLatestStart})
+		assert.Equal(t, []data.OccupancySegment{
+			segment(until("2025-07-01T08:00:00Z"), []int{1}, "legacy"),
+			segment(hours(8, 10), []int{0}, "current"),
+		}, result.Segments)
+	})
+}
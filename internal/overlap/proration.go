@@ -0,0 +1,146 @@
+package overlap
+
+import (
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+)
+
+// Prorate splits period across the rate periods it overlaps. Each share is the
+// units of its intersection with period over the units of period, so shares of
+// rate periods that tile period add up to exactly 1. Days are read in the zone
+// of the period's start. With an amount, each share gets the amount times its
+// fraction; with places as well, the amounts are rounded by the largest
+// remainder method, so they add up to the rounded total to the last digit.
+// A rate period takes a share when it overlaps period under the request's
+// boundary; units measure the distance between the ends of an intersection,
+// so one that only touches period under "[]" takes a share of zero.
+func (os *overlapService) Prorate(period data.DateRange, rates []data.RatePeriod, opts data.ProrationOptions) (data.ProrationResult, error) {
+	os.Logger.Info("Prorating billing period with overlapservice")
+	dayCount := opts.DayCount
+	if dayCount == "" {
+		dayCount = data.DayCountActualSeconds
+	}
+	boundary := os.boundary(opts.OverlapOptions)
+	result := data.ProrationResult{Shares: make([]data.ProrationShare, 0), DayCount: dayCount, Boundary: boundary}
+
+	if !period.HasStart() || !period.HasEnd() {
+		return result, &FieldError{Field: "period", Message: "proration needs a billing period with a start and an end"}
+	}
+	loc := period.Start.Location()
+	total := units(dayCount, period.Start, period.End, loc)
+	if total.Sign() == 0 {
+		return result, &FieldError{Field: "period", Message: "the billing period is empty under the " + string(dayCount) + " day count"}
+	}
+	result.Units = data.NewDecimal(total)
+
+	for i, rate := range rates {
+		r, ok := intersection(rate.Range, period, boundary)
+		if !ok {
+			continue
+		}
+		u := units(dayCount, r.Start, r.End, loc)
+		fraction := data.NewDecimal(new(big.Rat).Quo(u, total))
+		share := data.ProrationShare{
+			Index:        i,
+			ID:           rate.ID,
+			Intersection: r,
+			Units:        data.NewDecimal(u),
+			Fraction:     fraction,
+			Value:        *rate.Value,
+			Weighted:     fraction.Mul(*rate.Value),
+		}
+		result.Covered = result.Covered.Add(share.Fraction)
+		result.WeightedValue = result.WeightedValue.Add(share.Weighted)
+		result.Shares = append(result.Shares, share)
+	}
+
+	if opts.Amount != nil {
+		amount := allocate(result.Shares, *opts.Amount, opts.Places)
+		result.Amount = &amount
+	}
+	return result, nil
+}
+
+// units measures [start,end) under dayCount, in seconds or in days.
+func units(dayCount data.DayCount, start, end time.Time, loc *time.Location) *big.Rat {
+	switch dayCount {
+	case data.DayCountActualDays:
+		days := (civilDay(end.In(loc)).Unix() - civilDay(start.In(loc)).Unix()) / (24 * 60 * 60)
+		return new(big.Rat).SetInt64(days)
+	case data.DayCountThirty360:
+		return new(big.Rat).SetInt64(thirty360(end.In(loc)) - thirty360(start.In(loc)))
+	default:
+		seconds := new(big.Rat).SetInt64(end.Unix() - start.Unix())
+		nanos := big.NewRat(int64(end.Nanosecond()-start.Nanosecond()), int64(time.Second))
+		return seconds.Add(seconds, nanos)
+	}
+}
+
+// thirty360 numbers the days of a calendar in which every month has 30 days
+// and the 31st is the 30th. The difference of two numbers is the 30E/360 day
+// count between them.
+func thirty360(t time.Time) int64 {
+	y, m, d := t.Date()
+	return 360*int64(y) + 30*int64(m-1) + int64(min(d, 30))
+}
+
+// allocate sets the amount of each share to amount times its fraction and
+// returns their sum. With places, every amount is rounded down to that many
+// decimal places and the units still missing from the rounded total go, one
+// each, to the shares with the largest remainders, earlier shares first.
+func allocate(shares []data.ProrationShare, amount data.Decimal, places *int) data.Decimal {
+	var sum data.Decimal
+	if places == nil {
+		for i := range shares {
+			a := amount.Mul(shares[i].Fraction)
+			shares[i].Amount = &a
+			sum = sum.Add(a)
+		}
+		return sum
+	}
+
+	// Work on the size of the amount in units of the last place, so rounding
+	// down is the same for credits and charges.
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(*places)), nil))
+	size := new(big.Rat).Abs(amount.Rat())
+	size.Mul(size, scale)
+
+	exact := new(big.Rat)
+	floors := make([]*big.Int, len(shares))
+	remainders := make([]*big.Rat, len(shares))
+	assigned := new(big.Int)
+	for i, s := range shares {
+		e := new(big.Rat).Mul(size, s.Fraction.Rat())
+		exact.Add(exact, e)
+		floors[i] = new(big.Int).Quo(e.Num(), e.Denom())
+		remainders[i] = new(big.Rat).Sub(e, new(big.Rat).SetInt(floors[i]))
+		assigned.Add(assigned, floors[i])
+	}
+	half := big.NewRat(1, 2)
+	rounded := new(big.Rat).Add(exact, half)
+	missing := new(big.Int).Sub(new(big.Int).Quo(rounded.Num(), rounded.Denom()), assigned)
+
+	order := make([]int, len(shares))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]].Cmp(remainders[order[b]]) > 0
+	})
+	for _, i := range order[:missing.Int64()] {
+		floors[i].Add(floors[i], big.NewInt(1))
+	}
+
+	for i := range shares {
+		a := data.NewDecimal(new(big.Rat).Quo(new(big.Rat).SetInt(floors[i]), scale))
+		if amount.Rat().Sign() < 0 {
+			a = data.Decimal{}.Sub(a)
+		}
+		shares[i].Amount = &a
+		sum = sum.Add(a)
+	}
+	return sum
+}
@@ -0,0 +1,199 @@
+package overlap
+
+import (
+	"testing"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func rate(id, value string, r data.DateRange) data.RatePeriod {
+	return data.RatePeriod{ID: id, Range: r, Value: ptr(decimal(value))}
+}
+
+func amounts(result data.ProrationResult) []string {
+	out := make([]string, len(result.Shares))
+	for i, s := range result.Shares {
+		out[i] = s.Amount.String()
+	}
+	return out
+}
+
+func TestOverlapService_Prorate(t *testing.T) {
+	january := createDateRange("2025-01-01T00:00:00Z", "2025-02-01T00:00:00Z")
+	rates := []data.RatePeriod{
+		rate("old", "30", createDateRange("2024-12-01T00:00:00Z", "2025-01-11T00:00:00Z")),
+		rate("new", "31", from("2025-01-11T00:00:00Z")),
+		rate("later", "99", from("2025-03-01T00:00:00Z")),
+	}
+
+	testCases := []struct {
+		name      string
+		period    data.DateRange
+		rates     []data.RatePeriod
+		opts      data.ProrationOptions
+		units     string
+		fractions []string
+		weighted  string
+		amounts   []string
+		total     string
+	}{
+		{
+			name:      "Actual Days",
+			period:    january,
+			rates:     rates,
+			opts:      data.ProrationOptions{DayCount: data.DayCountActualDays, Amount: ptr(decimal("100"))},
+			units:     "31",
+			fractions: []string{"10/31", "21/31"},
+			weighted:  "951/31",
+			amounts:   []string{"1000/31", "2100/31"},
+			total:     "100",
+		},
+		{
+			name:      "Rounded Amounts Add Up",
+			period:    january,
+			rates:     rates,
+			opts:      data.ProrationOptions{DayCount: data.DayCountActualDays, Amount: ptr(decimal("100")), Places: ptr(2)},
+			units:     "31",
+			fractions: []string{"10/31", "21/31"},
+			weighted:  "951/31",
+			amounts:   []string{"32.26", "67.74"},
+			total:     "100",
+		},
+		{
+			name:      "Credit",
+			period:    january,
+			rates:     rates,
+			opts:      data.ProrationOptions{DayCount: data.DayCountActualDays, Amount: ptr(decimal("-100")), Places: ptr(2)},
+			units:     "31",
+			fractions: []string{"10/31", "21/31"},
+			weighted:  "951/31",
+			amounts:   []string{"-32.26", "-67.74"},
+			total:     "-100",
+		},
+		{
+			name:   "Actual Seconds",
+			period: createDateRange("2025-01-01T00:00:00Z", "2025-01-02T00:00:00Z"),
+			rates: []data.RatePeriod{
+				rate("night", "2", until("2025-01-01T06:00:00Z")),
+				rate("day", "4.4", from("2025-01-01T06:00:00Z")),
+			},
+			units:     "86400",
+			fractions: []string{"0.25", "0.75"},
+			weighted:  "3.8",
+		},
+		{
+			name:   "Thirty 360",
+			period: createDateRange("2025-01-01T00:00:00Z", "2025-03-01T00:00:00Z"),
+			rates: []data.RatePeriod{
+				rate("a", "10", createDateRange("2025-01-01T00:00:00Z", "2025-01-31T00:00:00Z")),
+				rate("b", "20", createDateRange("2025-01-31T00:00:00Z", "2025-03-01T00:00:00Z")),
+			},
+			opts:      data.ProrationOptions{DayCount: data.DayCountThirty360},
+			units:     "60",
+			fractions: []string{"29/60", "31/60"},
+			weighted:  "91/6",
+		},
+		{
+			name:   "Equal Thirds",
+			period: createDateRange("2025-01-01T00:00:00Z", "2025-01-04T00:00:00Z"),
+			rates: []data.RatePeriod{
+				rate("a", "1", createDateRange("2025-01-01T00:00:00Z", "2025-01-02T00:00:00Z")),
+				rate("b", "1", createDateRange("2025-01-02T00:00:00Z", "2025-01-03T00:00:00Z")),
+				rate("c", "1", createDateRange("2025-01-03T00:00:00Z", "2025-01-04T00:00:00Z")),
+			},
+			opts:      data.ProrationOptions{Amount: ptr(decimal("100")), Places: ptr(2)},
+			units:     "259200",
+			fractions: []string{"1/3", "1/3", "1/3"},
+			weighted:  "1",
+			amounts:   []string{"33.34", "33.33", "33.33"},
+			total:     "100",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := newTestService()
+
+			result, err := service.Prorate(tc.period, tc.rates, tc.opts)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.units, result.Units.String())
+			fractions := make([]string, len(result.Shares))
+			for i, s := range result.Shares {
+				fractions[i] = s.Fraction.String()
+			}
+			assert.Equal(t, tc.fractions, fractions)
+			assert.Equal(t, "1", result.Covered.String())
+			assert.Equal(t, tc.weighted, result.WeightedValue.String())
+			if tc.amounts == nil {
+				assert.Nil(t, result.Amount)
+				return
+			}
+			assert.Equal(t, tc.amounts, amounts(result))
+			assert.Equal(t, tc.total, result.Amount.String())
+		})
+	}
+}
+
+func TestOverlapService_ProrateSkipsRatesOutsideThePeriod(t *testing.T) {
+	service := newTestService()
+	rates := []data.RatePeriod{
+		rate("before", "1", createDateRange("2024-01-01T00:00:00Z", "2025-01-01T00:00:00Z")),
+		rate("half", "2", createDateRange("2025-01-01T00:00:00Z", "2025-01-16T12:00:00Z")),
+	}
+
+	result, err := service.Prorate(createDateRange("2025-01-01T00:00:00Z", "2025-02-01T00:00:00Z"), rates, data.ProrationOptions{})
+
+	require.NoError(t, err)
+	require.Len(t, result.Shares, 1)
+	assert.Equal(t, 1, result.Shares[0].Index)
+	assert.Equal(t, "half", result.Shares[0].ID)
+	assert.Equal(t, "0.5", result.Covered.String(), "half of January has no rate")
+	assert.Equal(t, data.DayCountActualSeconds, result.DayCount)
+	assert.Equal(t, data.BoundaryClosedOpen, result.Boundary)
+}
+
+func TestOverlapService_ProrateClosedBoundary(t *testing.T) {
+	service := newTestService()
+	rates := []data.RatePeriod{
+		rate("before", "1", createDateRange("2024-01-01T00:00:00Z", "2025-01-01T00:00:00Z")),
+		rate("half", "2", createDateRange("2025-01-01T00:00:00Z", "2025-01-16T12:00:00Z")),
+	}
+	opts := data.ProrationOptions{OverlapOptions: data.OverlapOptions{Boundary: data.BoundaryClosed}}
+
+	result, err := service.Prorate(createDateRange("2025-01-01T00:00:00Z", "2025-02-01T00:00:00Z"), rates, opts)
+
+	require.NoError(t, err)
+	assert.Equal(t, data.BoundaryClosed, result.Boundary)
+	require.Len(t, result.Shares, 2, "the rate period that touches the start takes a share under []")
+	assert.Equal(t, "before", result.Shares[0].ID)
+	assert.Equal(t, createDateRange("2025-01-01T00:00:00Z", "2025-01-01T00:00:00Z"), result.Shares[0].Intersection)
+	assert.Equal(t, "0", result.Shares[0].Units.String())
+	assert.Equal(t, "0", result.Shares[0].Fraction.String())
+	assert.Equal(t, "0.5", result.Covered.String())
+}
+
+func TestOverlapService_ProrateErrors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		period data.DateRange
+		opts   data.ProrationOptions
+	}{
+		{"Open Period", from("2025-01-01T00:00:00Z"), data.ProrationOptions{}},
+		{"Less Than A Day", createDateRange("2025-01-01T06:00:00Z", "2025-01-01T18:00:00Z"), data.ProrationOptions{DayCount: data.DayCountActualDays}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := newTestService()
+
+			_, err := service.Prorate(tc.period, []data.RatePeriod{rate("", "1", tc.period)}, tc.opts)
+
+			var fieldErr *FieldError
+			require.ErrorAs(t, err, &fieldErr)
+			assert.Equal(t, "period", fieldErr.Field)
+		})
+	}
+}
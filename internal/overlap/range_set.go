@@ -0,0 +1,50 @@
+package overlap
+
+import (
+	"github.com/keshu12345/overlap-avalara/data"
+)
+
+// Range-set operations leave the set algebra to the interval engine; see
+// interval.Engine for how boundary modes apply. They work on the ranges as
+// given: padding only widens ranges where conflicts are looked for.
+
+func (os *overlapService) Union(a, b []data.DateRange, opts data.OverlapOptions) data.RangeSetResult {
+	os.Logger.Info("Computing range set union with overlapservice")
+	boundary := os.boundary(opts)
+	ranges := times.Union(toIntervals(a), toIntervals(b), boundary)
+	return data.RangeSetResult{Ranges: fromIntervals(ranges), Boundary: boundary}
+}
+
+func (os *overlapService) IntersectSets(a, b []data.DateRange, opts data.OverlapOptions) data.RangeSetResult {
+	os.Logger.Info("Computing range set intersection with overlapservice")
+	boundary := os.boundary(opts)
+	ranges := times.IntersectSets(toIntervals(a), toIntervals(b), boundary)
+	return data.RangeSetResult{Ranges: fromIntervals(ranges), Boundary: boundary}
+}
+
+func (os *overlapService) Difference(a, b []data.DateRange, opts data.OverlapOptions) data.RangeSetResult {
+	os.Logger.Info("Computing range set difference with overlapservice")
+	boundary := os.boundary(opts)
+	ranges := times.Difference(toIntervals(a), toIntervals(b), boundary)
+	return data.RangeSetResult{Ranges: fromPieces(ranges, boundary), Boundary: boundary}
+}
+
+func (os *overlapService) SymmetricDifference(a, b []data.DateRange, opts data.OverlapOptions) data.RangeSetResult {
+	os.Logger.Info("Computing range set symmetric difference with overlapservice")
+	boundary := os.boundary(opts)
+	ranges := times.SymmetricDifference(toIntervals(a), toIntervals(b), boundary)
+	return data.RangeSetResult{Ranges: fromPieces(ranges, boundary), Boundary: boundary}
+}
+
+func (os *overlapService) Complement(set []data.DateRange, window data.DateRange, opts data.OverlapOptions) data.RangeSetResult {
+	os.Logger.Info("Computing range set complement with overlapservice")
+	boundary := os.boundary(opts)
+	return data.RangeSetResult{Ranges: complement(set, window, boundary), Boundary: boundary}
+}
+
+// complement returns the parts of window that no member of set covers. The
+// window itself is never padded. Parts whose ends differ from b carry their
+// own boundary.
+func complement(set []data.DateRange, window data.DateRange, b data.Boundary) data.RangeSet {
+	return fromPieces(times.Complement(toIntervals(set), toInterval(window), b), b)
+}
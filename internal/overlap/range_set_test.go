@@ -0,0 +1,158 @@
+package overlap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+)
+
+// hours builds a range on 2025-07-01 from whole hours, which keeps the set tables readable.
+func hours(start, end int) data.DateRange {
+	day := mustParseTime("2025-07-01T00:00:00Z")
+	return data.DateRange{Start: day.Add(time.Duration(start) * time.Hour), End: day.Add(time.Duration(end) * time.Hour)}
+}
+
+// bounded marks r with the ends it holds, as set operations do for pieces
+// whose ends differ from the boundary of the result.
+func bounded(r data.DateRange, b data.Boundary) data.DateRange {
+	r.Boundary = b
+	return r
+}
+
+func TestOverlapService_RangeSetOperations(t *testing.T) {
+	a := []data.DateRange{hours(13, 15), hours(8, 10), hours(9, 11), hours(11, 12)}
+	b := []data.DateRange{hours(10, 14), hours(16, 17)}
+
+	testCases := []struct {
+		name     string
+		boundary data.Boundary
+		run      func(OverlapService, data.OverlapOptions) data.RangeSetResult
+		expected data.RangeSet
+	}{
+		{
+			name:     "Union Merges Overlapping And Touching Ranges",
+			boundary: data.BoundaryClosedOpen,
+			run: func(s OverlapService, o data.OverlapOptions) data.RangeSetResult {
+				return s.Union(a, nil, o)
+			},
+			expected: data.RangeSet{hours(8, 12), hours(13, 15)},
+		},
+		{
+			name:     "Union Of Both Sets",
+			boundary: data.BoundaryClosedOpen,
+			run: func(s OverlapService, o data.OverlapOptions) data.RangeSetResult {
+				return s.Union(a, b, o)
+			},
+			expected: data.RangeSet{hours(8, 15), hours(16, 17)},
+		},
+		{
+			name:     "Open Union Keeps Touching Ranges Apart",
+			boundary: data.BoundaryOpen,
+			run: func(s OverlapService, o data.OverlapOptions) data.RangeSetResult {
+				return s.Union(a, nil, o)
+			},
+			expected: data.RangeSet{hours(8, 11), hours(11, 12), hours(13, 15)},
+		},
+		{
+			name:     "Intersection",
+			boundary: data.BoundaryClosedOpen,
+			run: func(s OverlapService, o data.OverlapOptions) data.RangeSetResult {
+				return s.IntersectSets(a, b, o)
+			},
+			expected: data.RangeSet{hours(10, 12), hours(13, 14)},
+		},
+		{
+			name:     "Closed Intersection Keeps Shared Instants",
+			boundary: data.BoundaryClosed,
+			run: func(s OverlapService, o data.OverlapOptions) data.RangeSetResult {
+				return s.IntersectSets([]data.DateRange{hours(8, 10)}, []data.DateRange{hours(10, 12)}, o)
+			},
+			expected: data.RangeSet{hours(10, 10)},
+		},
+		{
+			name:     "Difference",
+			boundary: data.BoundaryClosedOpen,
+			run: func(s OverlapService, o data.OverlapOptions) data.RangeSetResult {
+				return s.Difference(a, b, o)
+			},
+			expected: data.RangeSet{hours(8, 10), hours(14, 15)},
+		},
+		{
+			name:     "Difference Splits A Range",
+			boundary: data.BoundaryClosedOpen,
+			run: func(s OverlapService, o data.OverlapOptions) data.RangeSetResult {
+				return s.Difference([]data.DateRange{hours(8, 18)}, b, o)
+			},
+			expected: data.RangeSet{hours(8, 10), hours(14, 16), hours(17, 18)},
+		},
+		{
+			name:     "Symmetric Difference",
+			boundary: data.BoundaryClosedOpen,
+			run: func(s OverlapService, o data.OverlapOptions) data.RangeSetResult {
+				return s.SymmetricDifference(a, b, o)
+			},
+			expected: data.RangeSet{hours(8, 10), hours(12, 13), hours(14, 15), hours(16, 17)},
+		},
+		{
+			name:     "Closed Difference Leaves Out The Removed Ends",
+			boundary: data.BoundaryClosed,
+			run: func(s OverlapService, o data.OverlapOptions) data.RangeSetResult {
+				return s.Difference([]data.DateRange{hours(8, 18)}, []data.DateRange{hours(10, 12)}, o)
+			},
+			expected: data.RangeSet{bounded(hours(8, 10), data.BoundaryClosedOpen), bounded(hours(12, 18), data.BoundaryOpenClosed)},
+		},
+		{
+			name:     "Closed Symmetric Difference",
+			boundary: data.BoundaryClosed,
+			run: func(s OverlapService, o data.OverlapOptions) data.RangeSetResult {
+				return s.SymmetricDifference([]data.DateRange{hours(8, 12)}, []data.DateRange{hours(10, 14)}, o)
+			},
+			expected: data.RangeSet{bounded(hours(8, 10), data.BoundaryClosedOpen), bounded(hours(12, 14), data.BoundaryOpenClosed)},
+		},
+		{
+			name:     "Open Complement Keeps Uncovered Instants",
+			boundary: data.BoundaryOpen,
+			run: func(s OverlapService, o data.OverlapOptions) data.RangeSetResult {
+				return s.Complement([]data.DateRange{hours(8, 10), hours(10, 12)}, hours(6, 12), o)
+			},
+			expected: data.RangeSet{bounded(hours(6, 8), data.BoundaryOpenClosed), bounded(hours(10, 10), data.BoundaryClosed)},
+		},
+		{
+			name:     "Complement Within Window",
+			boundary: data.BoundaryClosedOpen,
+			run: func(s OverlapService, o data.OverlapOptions) data.RangeSetResult {
+				return s.Complement(a, hours(6, 20), o)
+			},
+			expected: data.RangeSet{hours(6, 8), hours(12, 13), hours(15, 20)},
+		},
+		{
+			name:     "Complement Of Empty Set Is The Window",
+			boundary: data.BoundaryClosedOpen,
+			run: func(s OverlapService, o data.OverlapOptions) data.RangeSetResult {
+				return s.Complement(nil, hours(6, 20), o)
+			},
+			expected: data.RangeSet{hours(6, 20)},
+		},
+		{
+			name:     "Complement Of Covering Set Is Empty",
+			boundary: data.BoundaryClosedOpen,
+			run: func(s OverlapService, o data.OverlapOptions) data.RangeSetResult {
+				return s.Complement(a, hours(8, 12), o)
+			},
+			expected: data.RangeSet{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := newTestService()
+
+			result := tc.run(service, data.OverlapOptions{Boundary: tc.boundary})
+
+			assert.Equal(t, tc.expected, result.Ranges)
+			assert.Equal(t, tc.boundary, result.Boundary)
+		})
+	}
+}
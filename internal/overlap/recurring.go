@@ -0,0 +1,188 @@
+package overlap
+
+import (
+	"sort"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/internal/recurrence"
+)
+
+const (
+	defaultClashLimit     = 10
+	defaultMaxOccurrences = 10000
+)
+
+// FindRecurringOverlaps returns the first clashes between the occurrences of
+// two schedules. Each series is expanded at most maxOccurrences times; once
+// one of them turns out to be finite, the other is only expanded across its
+// span, so a bounded series never forces the expansion of an unbounded one.
+// Padding widens every occurrence, so near misses are reported as clashes.
+func (os *overlapService) FindRecurringOverlaps(s1, s2 data.Schedule, opts data.RecurrenceOptions) (data.RecurringOverlapResult, error) {
+	os.Logger.Info("Finding recurring range clashes with overlapservice")
+	boundary := os.boundary(opts.OverlapOptions)
+	result := data.RecurringOverlapResult{Clashes: []data.OccurrenceClash{}, Boundary: boundary}
+
+	window := data.DateRange{}
+	if opts.Window != nil {
+		window = *opts.Window
+	}
+
+	first, truncated1, err := os.occurrences(s1, widen(window, opts.OverlapOptions))
+	if err != nil {
+		return result, err
+	}
+	window2, ok := clip(window, padAll(first, opts.OverlapOptions), truncated1)
+	if !ok {
+		return result, nil
+	}
+	second, truncated2, err := os.occurrences(s2, widen(window2, opts.OverlapOptions))
+	if err != nil {
+		return result, err
+	}
+	if truncated1 && !truncated2 {
+		window1, ok := clip(window, padAll(second, opts.OverlapOptions), false)
+		if !ok {
+			return result, nil
+		}
+		if first, truncated1, err = os.occurrences(s1, widen(window1, opts.OverlapOptions)); err != nil {
+			return result, err
+		}
+	}
+
+	limit := opts.Limit
+	if limit == 0 {
+		limit = defaultClashLimit
+	}
+	for _, c := range crossOverlaps(first, second, opts.OverlapOptions, boundary) {
+		if len(result.Clashes) == limit {
+			break
+		}
+		if _, inWindow := intersection(c.Intersection, window, boundary); inWindow {
+			result.Clashes = append(result.Clashes, c)
+		}
+	}
+	result.Overlap = len(result.Clashes) > 0
+	result.Truncated = truncated1 || truncated2
+	return result, nil
+}
+
+// occurrences expands a schedule across window. A fixed range is its own only occurrence.
+func (os *overlapService) occurrences(s data.Schedule, window data.DateRange) ([]data.DateRange, bool, error) {
+	if s.Recurring == nil {
+		if s.Range == nil {
+			return nil, false, nil
+		}
+		return []data.DateRange{*s.Range}, false, nil
+	}
+
+	series, err := toSeries(*s.Recurring)
+	if err != nil {
+		return nil, false, err
+	}
+	expanded, truncated := series.Expand(window.Start, window.End, os.maxOccurrences)
+	ranges := make([]data.DateRange, 0, len(expanded))
+	for _, o := range expanded {
+		ranges = append(ranges, data.DateRange{Start: o.Start, End: o.End})
+	}
+	return ranges, truncated, nil
+}
+
+func toSeries(r data.RecurringRange) (recurrence.Series, error) {
+	series := recurrence.Series{
+		Start:    r.DTStart,
+		Duration: time.Duration(r.Duration),
+		RDates:   r.RDates,
+		ExDates:  r.ExDates,
+	}
+	if r.RRule != "" {
+		rule, err := recurrence.Parse(r.RRule)
+		if err != nil {
+			return series, err
+		}
+		series.Rule = &rule
+	}
+	return series, nil
+}
+
+// clip narrows window to the span of a complete list of occurrences. A
+// truncated list says nothing about what lies beyond it, so window is kept.
+func clip(window data.DateRange, occurrences []data.DateRange, truncated bool) (data.DateRange, bool) {
+	if truncated {
+		return window, true
+	}
+	if len(occurrences) == 0 {
+		return data.DateRange{}, false
+	}
+	span := occurrences[0]
+	for _, o := range occurrences[1:] {
+		span.Start = earlierStart(span.Start, o.Start)
+		span.End = laterEnd(span.End, o.End)
+	}
+	return intersection(window, span, data.BoundaryClosed)
+}
+
+// crossOverlaps sweeps both lists in start order and pairs every range of a
+// with every range of b that it overlaps once padded, keeping one active list
+// per side.
+func crossOverlaps(a, b []data.DateRange, opts data.OverlapOptions, boundary data.Boundary) []data.OccurrenceClash {
+	type event struct {
+		r      data.DateRange
+		padded data.DateRange
+		side   int
+	}
+	events := make([]event, 0, len(a)+len(b))
+	for side, list := range [][]data.DateRange{a, b} {
+		for _, r := range list {
+			if padded := pad(r, opts); !isEmpty(padded, boundary) {
+				events = append(events, event{r: r, padded: padded, side: side})
+			}
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return compareStarts(events[i].padded.Start, events[j].padded.Start) < 0
+	})
+
+	clashes := make([]data.OccurrenceClash, 0)
+	var active [2][]event
+	for _, e := range events {
+		other := 1 - e.side
+		kept := active[other][:0]
+		for _, prev := range active[other] {
+			r, ok := intersection(prev.padded, e.padded, boundary)
+			if !ok {
+				// prev ended before e starts, and so did it for every later range.
+				continue
+			}
+			kept = append(kept, prev)
+			overlap := r.Duration()
+			if !longEnough(overlap, prev.padded, e.padded, opts.MinOverlap) {
+				continue
+			}
+			first, second := prev, e
+			if e.side == 0 {
+				first, second = e, prev
+			}
+			clashes = append(clashes, data.OccurrenceClash{
+				First:           first.r,
+				Second:          second.r,
+				Intersection:    r,
+				RawIntersection: rawIntersection(first.r, second.r, opts, boundary),
+				Duration:        overlap,
+				FirstCoverage:   coverage(overlap, first.padded),
+				SecondCoverage:  coverage(overlap, second.padded),
+				Jaccard:         jaccard(overlap, first.padded, second.padded),
+			})
+		}
+		active[other] = kept
+		active[e.side] = append(active[e.side], e)
+	}
+
+	sort.SliceStable(clashes, func(i, j int) bool {
+		if c := compareStarts(clashes[i].Intersection.Start, clashes[j].Intersection.Start); c != 0 {
+			return c < 0
+		}
+		return compareStarts(clashes[i].First.Start, clashes[j].First.Start) < 0
+	})
+	return clashes
+}
@@ -0,0 +1,171 @@
+package overlap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/config"
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func recurring(dtstart string, duration time.Duration, rrule string) data.Schedule {
+	return data.Schedule{Recurring: &data.RecurringRange{
+		DTStart:  mustParseTime(dtstart),
+		Duration: data.Duration(duration),
+		RRule:    rrule,
+	}}
+}
+
+func fixed(r data.DateRange) data.Schedule {
+	return data.Schedule{Range: &r}
+}
+
+func clashStarts(result data.RecurringOverlapResult) []string {
+	out := make([]string, 0, len(result.Clashes))
+	for _, c := range result.Clashes {
+		out = append(out, c.Intersection.Start.Format(time.RFC3339))
+	}
+	return out
+}
+
+func TestOverlapService_FindRecurringOverlaps(t *testing.T) {
+	mondays := recurring("2025-07-07T09:00:00Z", 2*time.Hour, "FREQ=WEEKLY;BYDAY=MO")
+
+	testCases := []struct {
+		name      string
+		s1, s2    data.Schedule
+		opts      data.RecurrenceOptions
+		expected  []string
+		truncated bool
+	}{
+		{
+			name:     "Weekly Series Against Fixed Range",
+			s1:       mondays,
+			s2:       fixed(createDateRange("2025-07-14T10:00:00Z", "2025-07-22T00:00:00Z")),
+			expected: []string{"2025-07-14T10:00:00Z", "2025-07-21T09:00:00Z"},
+		},
+		{
+			name:     "Fixed Range Against Weekly Series Keeps Sides",
+			s1:       fixed(createDateRange("2025-07-14T10:00:00Z", "2025-07-14T12:00:00Z")),
+			s2:       mondays,
+			expected: []string{"2025-07-14T10:00:00Z"},
+		},
+		{
+			name:      "Two Unbounded Series Limited By Count",
+			s1:        mondays,
+			s2:        recurring("2025-07-01T10:00:00Z", time.Hour, "FREQ=DAILY"),
+			opts:      data.RecurrenceOptions{Limit: 3},
+			expected:  []string{"2025-07-07T10:00:00Z", "2025-07-14T10:00:00Z", "2025-07-21T10:00:00Z"},
+			truncated: true,
+		},
+		{
+			name:     "Bounded Series Against Unbounded Series",
+			s1:       recurring("2025-07-01T10:00:00Z", time.Hour, "FREQ=DAILY"),
+			s2:       recurring("2030-01-07T09:00:00Z", 2*time.Hour, "FREQ=WEEKLY;COUNT=2"),
+			expected: []string{"2030-01-07T10:00:00Z", "2030-01-14T10:00:00Z"},
+		},
+		{
+			name:     "Touching Occurrences Do Not Clash Half-Open",
+			s1:       mondays,
+			s2:       recurring("2025-07-07T11:00:00Z", time.Hour, "FREQ=WEEKLY;COUNT=3"),
+			expected: []string{},
+		},
+		{
+			name:     "Touching Occurrences Clash Closed",
+			s1:       mondays,
+			s2:       recurring("2025-07-07T11:00:00Z", time.Hour, "FREQ=WEEKLY;COUNT=2"),
+			opts:     data.RecurrenceOptions{OverlapOptions: data.OverlapOptions{Boundary: data.BoundaryClosed}},
+			expected: []string{"2025-07-07T11:00:00Z", "2025-07-14T11:00:00Z"},
+		},
+		{
+			name: "Window Bounds The Search",
+			s1:   mondays,
+			s2:   recurring("2025-07-01T10:00:00Z", time.Hour, "FREQ=DAILY"),
+			opts: data.RecurrenceOptions{Window: func() *data.DateRange {
+				r := createDateRange("2025-08-01T00:00:00Z", "2025-08-15T00:00:00Z")
+				return &r
+			}()},
+			expected: []string{"2025-08-04T10:00:00Z", "2025-08-11T10:00:00Z"},
+		},
+		{
+			name: "Excluded Occurrence",
+			s1: data.Schedule{Recurring: &data.RecurringRange{
+				DTStart:  mustParseTime("2025-07-07T09:00:00Z"),
+				Duration: data.Duration(2 * time.Hour),
+				RRule:    "FREQ=WEEKLY;COUNT=3",
+				ExDates:  []time.Time{mustParseTime("2025-07-14T09:00:00Z")},
+			}},
+			s2:       fixed(createDateRange("2025-07-01T00:00:00Z", "2025-08-01T00:00:00Z")),
+			expected: []string{"2025-07-07T09:00:00Z", "2025-07-21T09:00:00Z"},
+		},
+		{
+			name:      "Unbounded Series That Never Clash",
+			s1:        recurring("2025-07-07T09:00:00Z", time.Hour, "FREQ=DAILY"),
+			s2:        recurring("2025-07-07T12:00:00Z", time.Hour, "FREQ=DAILY"),
+			expected:  []string{},
+			truncated: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := newTestService().FindRecurringOverlaps(tc.s1, tc.s2, tc.opts)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, clashStarts(result))
+			assert.Equal(t, len(tc.expected) > 0, result.Overlap)
+			assert.Equal(t, tc.truncated, result.Truncated)
+		})
+	}
+}
+
+func TestOverlapService_FindRecurringOverlapsPairsOccurrences(t *testing.T) {
+	service := newTestService()
+	s1 := recurring("2025-07-07T09:00:00Z", 2*time.Hour, "FREQ=WEEKLY;BYDAY=MO")
+	s2 := fixed(createDateRange("2025-07-14T10:00:00Z", "2025-07-14T12:00:00Z"))
+
+	result, err := service.FindRecurringOverlaps(s1, s2, data.RecurrenceOptions{})
+
+	require.NoError(t, err)
+	require.Len(t, result.Clashes, 1)
+	assert.Equal(t, data.OccurrenceClash{
+		First:          createDateRange("2025-07-14T09:00:00Z", "2025-07-14T11:00:00Z"),
+		Second:         createDateRange("2025-07-14T10:00:00Z", "2025-07-14T12:00:00Z"),
+		Intersection:   createDateRange("2025-07-14T10:00:00Z", "2025-07-14T11:00:00Z"),
+		Duration:       data.Duration(time.Hour),
+		FirstCoverage:  0.5,
+		SecondCoverage: 0.5,
+		Jaccard:        1.0 / 3,
+	}, result.Clashes[0])
+	assert.Equal(t, data.BoundaryClosedOpen, result.Boundary)
+}
+
+func TestOverlapService_FindRecurringOverlapsExpansionLimit(t *testing.T) {
+	mockLogger := &MockLogger{}
+	mockLogger.On("Info", mock.Anything).Return()
+	service := New(mockLogger, &config.Configuration{Overlap: config.Overlap{MaxOccurrences: 5}}, nil)
+
+	s1 := recurring("2025-07-01T09:00:00Z", time.Hour, "FREQ=DAILY")
+	s2 := fixed(from("2025-07-01T00:00:00Z"))
+
+	result, err := service.FindRecurringOverlaps(s1, s2, data.RecurrenceOptions{Limit: 100})
+
+	require.NoError(t, err)
+	assert.Len(t, result.Clashes, 5)
+	assert.True(t, result.Truncated)
+}
+
+func TestOverlapService_FindRecurringOverlapsInvalidRule(t *testing.T) {
+	service := newTestService()
+
+	_, err := service.FindRecurringOverlaps(
+		recurring("2025-07-01T09:00:00Z", time.Hour, "FREQ=FORTNIGHTLY"),
+		fixed(createDateRange("2025-07-01T00:00:00Z", "2025-08-01T00:00:00Z")),
+		data.RecurrenceOptions{},
+	)
+
+	assert.Error(t, err)
+}
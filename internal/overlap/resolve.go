@@ -0,0 +1,205 @@
+package overlap
+
+import (
+	"sort"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+)
+
+// editOrder is the order in which edits of equal cost are preferred: a shift
+// keeps the length of the range, a trim does not.
+var editOrder = []data.EditKind{data.EditShiftLater, data.EditShiftEarlier, data.EditTrimStart, data.EditTrimEnd}
+
+// ResolveConflicts suggests edits that leave no two ranges overlapping. Fixed
+// ranges stay where they are. The movable ranges are then placed one by one in
+// order of their start, each with the cheapest allowed edit that keeps it
+// clear of the ranges placed before it, or unchanged when it has no conflict
+// with them that reaches the minimum overlap. The suggestions are greedy: an
+// edit is never revisited once later ranges are placed, so the total cost is
+// not always the smallest one possible. Padding must stay clear too, but
+// only the ranges themselves are edited. A range no allowed edit can clear
+// stays where it is and its conflicts are reported. Under a closed boundary
+// ranges that touch still conflict, so an edited range is kept one nanosecond,
+// the smallest step between two instants, away from the next; the other modes
+// let it end where the next one starts.
+func (os *overlapService) ResolveConflicts(ranges []data.ResolvableRange, opts data.ResolveOptions) data.ResolutionResult {
+	os.Logger.Info("Suggesting conflict resolutions with overlapservice")
+	boundary := os.boundary(opts.OverlapOptions)
+	result := data.ResolutionResult{Edits: make([]data.RangeEdit, 0), Ranges: make([]data.IdentifiedRange, len(ranges)), Boundary: boundary}
+	padOpts := opts.OverlapOptions
+	allowed := allowedEdits(opts.Edits)
+
+	placed := make([]data.DateRange, 0)
+	movable := make([]int, 0)
+	for i, r := range ranges {
+		result.Ranges[i] = data.IdentifiedRange{ID: r.ID, Range: r.Range}
+		if r.Fixed {
+			placed = append(placed, pad(r.Range, padOpts))
+		} else {
+			movable = append(movable, i)
+		}
+	}
+	sort.SliceStable(movable, func(a, b int) bool {
+		return compareStarts(pad(ranges[movable[a]].Range, padOpts).Start, pad(ranges[movable[b]].Range, padOpts).Start) < 0
+	})
+
+	// Placement reads every range as [), which keeps the same ranges apart as
+	// (] and () do, and as [] does once each range is spaced.
+	taken := fromIntervals(times.Normalize(toIntervals(spaceAll(placed, boundary)), data.BoundaryClosedOpen))
+	for _, i := range movable {
+		raw := ranges[i].Range
+		if conflicts(pad(raw, padOpts), placed, boundary, opts.MinOverlap) {
+			edit, ok := cheapestEdit(raw, spaced(pad(raw, padOpts), boundary), taken, allowed, boundary)
+			if ok && edit.Cost > 0 {
+				edit.Index, edit.ID = i, ranges[i].ID
+				result.Edits = append(result.Edits, edit)
+				result.Cost += edit.Cost
+				raw = edit.To
+				result.Ranges[i].Range = raw
+			}
+		}
+		placed = append(placed, pad(raw, padOpts))
+		taken = fromIntervals(times.Union(toIntervals(taken), toIntervals([]data.DateRange{spaced(pad(raw, padOpts), boundary)}), data.BoundaryClosedOpen))
+	}
+
+	padded := padIdentified(result.Ranges, padOpts)
+	result.Conflicts = make([]data.OverlapPair, 0)
+	for _, p := range times.Overlaps(toIntervals(padded), boundary) {
+		r := fromInterval(p.Intersection)
+		if !longEnough(r.Duration(), padded[p.First], padded[p.Second], opts.MinOverlap) {
+			continue
+		}
+		pair := newOverlapPair(result.Ranges, padded, p.First, p.Second, r)
+		pair.RawIntersection = rawIntersection(result.Ranges[p.First].Range, result.Ranges[p.Second].Range, padOpts, boundary)
+		result.Conflicts = append(result.Conflicts, pair)
+	}
+	result.Resolved = len(result.Conflicts) == 0
+	return result
+}
+
+// spaced returns r as placement reads it under boundary b. Under [] it reaches
+// one nanosecond past its end, so a range placed after it starts no earlier
+// than that and the two do not touch.
+func spaced(r data.DateRange, b data.Boundary) data.DateRange {
+	if b == data.BoundaryClosed && r.HasEnd() {
+		r.End = r.End.Add(time.Nanosecond)
+	}
+	return r
+}
+
+func spaceAll(ranges []data.DateRange, b data.Boundary) []data.DateRange {
+	spacedRanges := make([]data.DateRange, len(ranges))
+	for i, r := range ranges {
+		spacedRanges[i] = spaced(r, b)
+	}
+	return spacedRanges
+}
+
+// conflicts reports whether p overlaps any of placed by at least the minimum.
+func conflicts(p data.DateRange, placed []data.DateRange, boundary data.Boundary, min *data.MinOverlap) bool {
+	for _, q := range placed {
+		if r, ok := intersection(p, q, boundary); ok && longEnough(r.Duration(), p, q, min) {
+			return true
+		}
+	}
+	return false
+}
+
+func allowedEdits(edits []data.EditKind) map[data.EditKind]bool {
+	allowed := make(map[data.EditKind]bool, len(editOrder))
+	for _, kind := range editOrder {
+		allowed[kind] = len(edits) == 0
+	}
+	for _, kind := range edits {
+		allowed[kind] = true
+	}
+	return allowed
+}
+
+// cheapestEdit returns the cheapest allowed edit of raw that keeps its padded
+// and spaced version p clear of taken, a sorted set of disjoint ranges. An
+// edit that would leave raw empty under boundary b is skipped. ok is false
+// when no allowed edit works.
+func cheapestEdit(raw, p data.DateRange, taken data.RangeSet, allowed map[data.EditKind]bool, b data.Boundary) (data.RangeEdit, bool) {
+	gaps := complement(taken, data.DateRange{}, data.BoundaryClosedOpen)
+
+	var best data.RangeEdit
+	found := false
+	for _, kind := range editOrder {
+		if !allowed[kind] {
+			continue
+		}
+		start, end, ok := fit(kind, p, gaps)
+		if !ok {
+			continue
+		}
+		to := raw
+		if to.HasStart() {
+			to.Start = to.Start.Add(start)
+		}
+		if to.HasEnd() {
+			to.End = to.End.Add(end)
+		}
+		if isEmpty(to, b) {
+			continue
+		}
+		cost := data.Duration(max(start.Abs(), end.Abs()))
+		if !found || cost < best.Cost {
+			best, found = data.RangeEdit{Kind: kind, From: raw, To: to, Cost: cost}, true
+		}
+	}
+	return best, found
+}
+
+// fit returns how far kind moves the start and the end of p so that it fits
+// in one of gaps, at the smallest distance.
+func fit(kind data.EditKind, p data.DateRange, gaps data.RangeSet) (start, end time.Duration, ok bool) {
+	switch kind {
+	case data.EditShiftLater:
+		if !p.HasStart() || !p.HasEnd() {
+			return 0, 0, false
+		}
+		length := p.End.Sub(p.Start)
+		for _, g := range gaps {
+			s := laterStart(g.Start, p.Start)
+			if !g.HasEnd() || g.End.Sub(s) >= length {
+				d := s.Sub(p.Start)
+				return d, d, true
+			}
+		}
+	case data.EditShiftEarlier:
+		if !p.HasStart() || !p.HasEnd() {
+			return 0, 0, false
+		}
+		length := p.End.Sub(p.Start)
+		for i := len(gaps) - 1; i >= 0; i-- {
+			g := gaps[i]
+			e := earlierEnd(g.End, p.End)
+			if !g.HasStart() || e.Sub(g.Start) >= length {
+				d := e.Sub(p.End)
+				return d, d, true
+			}
+		}
+	case data.EditTrimStart:
+		if !p.HasStart() {
+			return 0, 0, false
+		}
+		// The gap that holds the last instants of p becomes its new home.
+		for _, g := range gaps {
+			if compareEnds(g.End, p.End) >= 0 && (!g.HasStart() || !p.HasEnd() || g.Start.Before(p.End)) {
+				return laterStart(g.Start, p.Start).Sub(p.Start), 0, true
+			}
+		}
+	case data.EditTrimEnd:
+		if !p.HasEnd() {
+			return 0, 0, false
+		}
+		for _, g := range gaps {
+			if compareStarts(g.Start, p.Start) <= 0 && (!g.HasEnd() || !p.HasStart() || g.End.After(p.Start)) {
+				return 0, earlierEnd(g.End, p.End).Sub(p.End), true
+			}
+		}
+	}
+	return 0, 0, false
+}
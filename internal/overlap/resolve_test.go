@@ -0,0 +1,208 @@
+package overlap
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func movable(id string, r data.DateRange) data.ResolvableRange {
+	return data.ResolvableRange{ID: id, Range: r}
+}
+
+func pinned(id string, r data.DateRange) data.ResolvableRange {
+	return data.ResolvableRange{ID: id, Range: r, Fixed: true}
+}
+
+func minutes(start, end int) data.DateRange {
+	r := hours(0, 0)
+	return data.DateRange{Start: r.Start.Add(time.Duration(start) * time.Minute), End: r.Start.Add(time.Duration(end) * time.Minute)}
+}
+
+// nanos moves the start and the end of r by the given nanoseconds.
+func nanos(r data.DateRange, start, end int) data.DateRange {
+	return data.DateRange{Start: r.Start.Add(time.Duration(start)), End: r.End.Add(time.Duration(end))}
+}
+
+func TestOverlapService_ResolveConflicts(t *testing.T) {
+	testCases := []struct {
+		name     string
+		ranges   []data.ResolvableRange
+		opts     data.ResolveOptions
+		expected []data.RangeEdit
+	}{
+		{
+			name:     "No Conflicts",
+			ranges:   []data.ResolvableRange{movable("a", hours(9, 10)), movable("b", hours(10, 11))},
+			expected: []data.RangeEdit{},
+		},
+		{
+			name:     "Later Range Moves Past The Earlier One",
+			ranges:   []data.ResolvableRange{movable("a", hours(9, 11)), movable("b", hours(10, 12))},
+			expected: []data.RangeEdit{{Index: 1, ID: "b", Kind: data.EditShiftLater, From: hours(10, 12), To: hours(11, 13), Cost: data.Duration(time.Hour)}},
+		},
+		{
+			name:     "Fixed Range Stays",
+			ranges:   []data.ResolvableRange{pinned("meeting", hours(10, 12)), movable("a", hours(9, 11))},
+			expected: []data.RangeEdit{{Index: 1, ID: "a", Kind: data.EditShiftEarlier, From: hours(9, 11), To: hours(8, 10), Cost: data.Duration(time.Hour)}},
+		},
+		{
+			name:     "Trims Only",
+			ranges:   []data.ResolvableRange{pinned("meeting", hours(10, 12)), movable("a", hours(9, 11))},
+			opts:     data.ResolveOptions{Edits: []data.EditKind{data.EditTrimStart, data.EditTrimEnd}},
+			expected: []data.RangeEdit{{Index: 1, ID: "a", Kind: data.EditTrimEnd, From: hours(9, 11), To: hours(9, 10), Cost: data.Duration(time.Hour)}},
+		},
+		{
+			name:     "Cheapest Shift Wins",
+			ranges:   []data.ResolvableRange{pinned("x", hours(9, 10)), pinned("y", hours(12, 13)), movable("a", hours(9, 11))},
+			opts:     data.ResolveOptions{Edits: []data.EditKind{data.EditShiftLater}},
+			expected: []data.RangeEdit{{Index: 2, ID: "a", Kind: data.EditShiftLater, From: hours(9, 11), To: hours(10, 12), Cost: data.Duration(time.Hour)}},
+		},
+		{
+			name:     "Shift Skips A Gap Too Short",
+			ranges:   []data.ResolvableRange{pinned("x", hours(9, 10)), pinned("y", hours(11, 12)), movable("a", hours(9, 11))},
+			opts:     data.ResolveOptions{Edits: []data.EditKind{data.EditShiftLater}},
+			expected: []data.RangeEdit{{Index: 2, ID: "a", Kind: data.EditShiftLater, From: hours(9, 11), To: hours(12, 14), Cost: data.Duration(3 * time.Hour)}},
+		},
+		{
+			name:     "Padding Must Stay Clear",
+			ranges:   []data.ResolvableRange{movable("a", hours(9, 10)), movable("b", hours(10, 11))},
+			opts:     data.ResolveOptions{OverlapOptions: data.OverlapOptions{Padding: padding(0, 15*time.Minute)}},
+			expected: []data.RangeEdit{{Index: 1, ID: "b", Kind: data.EditShiftLater, From: hours(10, 11), To: minutes(615, 675), Cost: data.Duration(15 * time.Minute)}},
+		},
+		{
+			name:     "Open Ranges Shift Up To The Next",
+			ranges:   []data.ResolvableRange{movable("a", hours(9, 11)), movable("b", hours(10, 12))},
+			opts:     data.ResolveOptions{OverlapOptions: data.OverlapOptions{Boundary: data.BoundaryOpen}},
+			expected: []data.RangeEdit{{Index: 1, ID: "b", Kind: data.EditShiftLater, From: hours(10, 12), To: hours(11, 13), Cost: data.Duration(time.Hour)}},
+		},
+		{
+			name:     "Closed Ranges Shift Past The End",
+			ranges:   []data.ResolvableRange{movable("a", hours(9, 11)), movable("b", hours(10, 12))},
+			opts:     data.ResolveOptions{OverlapOptions: data.OverlapOptions{Boundary: data.BoundaryClosed}},
+			expected: []data.RangeEdit{{Index: 1, ID: "b", Kind: data.EditShiftLater, From: hours(10, 12), To: nanos(hours(11, 13), 1, 1), Cost: data.Duration(time.Hour + time.Nanosecond)}},
+		},
+		{
+			name:     "Closed Ranges Shift Before The Start",
+			ranges:   []data.ResolvableRange{pinned("meeting", hours(10, 12)), movable("a", hours(9, 11))},
+			opts:     data.ResolveOptions{OverlapOptions: data.OverlapOptions{Boundary: data.BoundaryClosed}},
+			expected: []data.RangeEdit{{Index: 1, ID: "a", Kind: data.EditShiftEarlier, From: hours(9, 11), To: nanos(hours(8, 10), -1, -1), Cost: data.Duration(time.Hour + time.Nanosecond)}},
+		},
+		{
+			name:     "Closed Range Trimmed Past The End",
+			ranges:   []data.ResolvableRange{pinned("x", hours(9, 10)), movable("a", hours(9, 11))},
+			opts:     data.ResolveOptions{Edits: []data.EditKind{data.EditTrimStart}, OverlapOptions: data.OverlapOptions{Boundary: data.BoundaryClosed}},
+			expected: []data.RangeEdit{{Index: 1, ID: "a", Kind: data.EditTrimStart, From: hours(9, 11), To: nanos(hours(10, 11), 1, 0), Cost: data.Duration(time.Hour + time.Nanosecond)}},
+		},
+		{
+			name:     "Overlap Below The Minimum Stays",
+			ranges:   []data.ResolvableRange{movable("a", hours(9, 11)), movable("b", minutes(650, 720))},
+			opts:     data.ResolveOptions{OverlapOptions: data.OverlapOptions{MinOverlap: &data.MinOverlap{Duration: data.Duration(15 * time.Minute)}}},
+			expected: []data.RangeEdit{},
+		},
+		{
+			name:     "Trim Start Of An Open Range",
+			ranges:   []data.ResolvableRange{pinned("old", hours(9, 12)), movable("new", from("2025-07-01T10:00:00Z"))},
+			expected: []data.RangeEdit{{Index: 1, ID: "new", Kind: data.EditTrimStart, From: from("2025-07-01T10:00:00Z"), To: from("2025-07-01T12:00:00Z"), Cost: data.Duration(2 * time.Hour)}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := newTestService()
+
+			result := service.ResolveConflicts(tc.ranges, tc.opts)
+
+			assert.True(t, result.Resolved)
+			assert.Empty(t, result.Conflicts)
+			assert.Equal(t, tc.expected, result.Edits)
+			var cost data.Duration
+			for _, e := range tc.expected {
+				cost += e.Cost
+				assert.Equal(t, e.To, result.Ranges[e.Index].Range)
+			}
+			assert.Equal(t, cost, result.Cost)
+		})
+	}
+}
+
+func TestOverlapService_ResolveConflictsUnresolved(t *testing.T) {
+	testCases := []struct {
+		name   string
+		ranges []data.ResolvableRange
+		opts   data.ResolveOptions
+	}{
+		{"Both Fixed", []data.ResolvableRange{pinned("a", hours(9, 11)), pinned("b", hours(10, 12))}, data.ResolveOptions{}},
+		{"Trim Would Empty The Range", []data.ResolvableRange{pinned("a", hours(9, 12)), movable("b", hours(10, 11))}, data.ResolveOptions{Edits: []data.EditKind{data.EditTrimStart, data.EditTrimEnd}}},
+		{"Open Range Cannot Shift", []data.ResolvableRange{pinned("a", hours(9, 12)), movable("b", from("2025-07-01T10:00:00Z"))}, data.ResolveOptions{Edits: []data.EditKind{data.EditShiftLater, data.EditShiftEarlier}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := newTestService()
+
+			result := service.ResolveConflicts(tc.ranges, tc.opts)
+
+			assert.False(t, result.Resolved)
+			assert.Empty(t, result.Edits)
+			require.Len(t, result.Conflicts, 1)
+			assert.Equal(t, 0, result.Conflicts[0].FirstIndex)
+			assert.Equal(t, 1, result.Conflicts[0].SecondIndex)
+			assert.Equal(t, tc.ranges[1].Range, result.Ranges[1].Range, "a range no edit can clear stays where it is")
+		})
+	}
+}
+
+// Each range takes its cheapest edit as it is placed, preferring a shift on
+// equal cost, so the total is not always the smallest: trimming the starts of
+// b and c would cost two hours here.
+func TestOverlapService_ResolveConflictsIsGreedy(t *testing.T) {
+	ranges := []data.ResolvableRange{pinned("a", hours(0, 4)), movable("b", hours(3, 5)), movable("c", hours(4, 6))}
+
+	result := newTestService().ResolveConflicts(ranges, data.ResolveOptions{})
+
+	assert.True(t, result.Resolved)
+	assert.Equal(t, []data.RangeEdit{
+		{Index: 1, ID: "b", Kind: data.EditShiftLater, From: hours(3, 5), To: hours(4, 6), Cost: data.Duration(time.Hour)},
+		{Index: 2, ID: "c", Kind: data.EditShiftLater, From: hours(4, 6), To: hours(6, 8), Cost: data.Duration(2 * time.Hour)},
+	}, result.Edits)
+	assert.Equal(t, data.Duration(3*time.Hour), result.Cost)
+}
+
+// With every edit allowed a bounded movable range can always be shifted past
+// the others, so any set of them around disjoint fixed ranges is resolved.
+func TestOverlapService_ResolveConflictsAlwaysResolves(t *testing.T) {
+	rng := rand.New(rand.NewSource(22))
+	base := mustParseTime("2025-01-01T00:00:00Z")
+	ranges := make([]data.ResolvableRange, 200)
+	for i := range ranges {
+		if i%10 == 0 {
+			start := base.Add(time.Duration(i) * 3 * time.Hour)
+			ranges[i] = pinned(fmt.Sprint(i), data.DateRange{Start: start, End: start.Add(time.Duration(1+rng.Intn(24)) * time.Hour)})
+			continue
+		}
+		start := base.Add(time.Duration(rng.Intn(500)) * time.Hour)
+		ranges[i] = movable(fmt.Sprint(i), data.DateRange{Start: start, End: start.Add(time.Duration(1+rng.Intn(24)) * time.Hour)})
+	}
+
+	for _, boundary := range []data.Boundary{data.BoundaryClosedOpen, data.BoundaryClosed} {
+		t.Run(string(boundary), func(t *testing.T) {
+			opts := data.OverlapOptions{Boundary: boundary}
+
+			result := newTestService().ResolveConflicts(ranges, data.ResolveOptions{OverlapOptions: opts})
+
+			assert.True(t, result.Resolved)
+			assert.Empty(t, newTestService().FindOverlaps(result.Ranges, opts).Pairs)
+			for i, r := range ranges {
+				if r.Fixed {
+					assert.Equal(t, r.Range, result.Ranges[i].Range)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package overlap
+
+import (
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/internal/calendar"
+	"github.com/keshu12345/overlap-avalara/internal/zone"
+)
+
+// FindSlots returns the earliest slots of the given duration within window
+// in which no participant is busy. Each free stretch long enough yields one
+// slot at its start, so the slots are the earliest candidates that do not
+// overlap each other. Padding around busy ranges is not free, and slots are
+// given in the zone of the working hours. Under any boundary a slot leaves out
+// the instants at which busy ranges start and end, see slotIn.
+func (os *overlapService) FindSlots(busy map[string][]data.DateRange, duration data.Duration, window data.DateRange, opts data.SlotOptions) (data.SlotResult, error) {
+	os.Logger.Info("Finding common free slots with overlapservice")
+	boundary := os.boundary(opts.OverlapOptions)
+	result := data.SlotResult{Slots: make([]data.Slot, 0), Boundary: boundary}
+
+	if !window.HasStart() || !window.HasEnd() {
+		return result, &FieldError{Field: "window", Message: "a slot search needs a window with a start and an end"}
+	}
+	allowed, loc, err := os.bookableTimes(window, opts, boundary)
+	if err != nil {
+		return result, err
+	}
+
+	taken := make([]data.DateRange, 0)
+	for _, ranges := range busy {
+		taken = append(taken, padAll(ranges, opts.OverlapOptions)...)
+	}
+	for _, free := range fromPieces(times.Difference(toIntervals(allowed), toIntervals(taken), boundary), boundary) {
+		if free.Duration() < duration {
+			continue
+		}
+		slot := slotIn(free, duration, boundary)
+		result.Slots = append(result.Slots, data.Slot{Range: inZone(slot, loc), Free: inZone(free, loc)})
+		if opts.Limit > 0 && len(result.Slots) == opts.Limit {
+			break
+		}
+	}
+	return result, nil
+}
+
+// slotIn places a slot of duration d at the start of free, a piece of the
+// free time under boundary b. The slot starts the way free does, so it never
+// takes the instant a busy range ends on. It ends the way b does when free
+// leaves room for that and is open otherwise, so it never takes the instant a
+// busy range starts on: under [] a slot right before a busy range is [).
+func slotIn(free data.DateRange, d data.Duration, b data.Boundary) data.DateRange {
+	ends := b
+	if free.Boundary != "" {
+		ends = free.Boundary
+	}
+	slot := data.DateRange{Start: free.Start, End: free.Start.Add(time.Duration(d))}
+	closedEnd := hasClosedEnd(b) && (slot.End.Before(free.End) || hasClosedEnd(ends))
+	if s := boundaryOf(hasClosedStart(ends), closedEnd); s != b {
+		slot.Boundary = s
+	}
+	return slot
+}
+
+// bookableTimes returns the parts of window that fall within the working
+// hours on working days, with the zone they are read in. Without working
+// hours or a calendar the whole window is bookable.
+func (os *overlapService) bookableTimes(window data.DateRange, opts data.SlotOptions, boundary data.Boundary) ([]data.DateRange, *time.Location, error) {
+	loc := time.UTC
+	var cal *calendar.Calendar
+	if opts.Calendar != "" {
+		var ok bool
+		if cal, ok = os.calendars.Get(opts.Calendar); !ok {
+			return nil, nil, &FieldError{Field: "calendar", Message: "unknown calendar " + opts.Calendar}
+		}
+		loc = cal.Location
+	}
+	if opts.Zone != "" {
+		var err error
+		if loc, err = zone.Load(opts.Zone); err != nil {
+			return nil, nil, &FieldError{Field: "zone", Message: err.Error()}
+		}
+	}
+	if len(opts.WorkingHours) == 0 && cal == nil {
+		return []data.DateRange{window}, loc, nil
+	}
+
+	hours := opts.WorkingHours
+	if len(hours) == 0 {
+		hours = []data.WorkingHours{{End: data.ClockTime(24 * time.Hour)}}
+	}
+	w := inZone(window, loc)
+	last := civilDay(w.End)
+	allowed := make([]data.DateRange, 0)
+	for day := civilDay(w.Start); !day.After(last); day = day.AddDate(0, 0, 1) {
+		if cal != nil && !cal.IsWorkingDay(data.CivilDate(day)) {
+			continue
+		}
+		for _, h := range hours {
+			if !worksOn(h, day.Weekday()) {
+				continue
+			}
+			shift := data.DateRange{Start: wallClock(day.Add(h.Start.Since()), loc), End: wallClock(day.Add(h.End.Since()), loc)}
+			if r, ok := intersection(shift, window, boundary); ok {
+				allowed = append(allowed, r)
+			}
+		}
+	}
+	return allowed, loc, nil
+}
+
+func worksOn(h data.WorkingHours, wd time.Weekday) bool {
+	if len(h.Days) == 0 {
+		return true
+	}
+	for _, name := range h.Days {
+		if day, ok := calendar.Weekday(name); ok && day == wd {
+			return true
+		}
+	}
+	return false
+}
+
+// wallClock returns the instant clocks in loc show wall, whose fields are read
+// in UTC. A time skipped by a DST change moves forward.
+func wallClock(wall time.Time, loc *time.Location) time.Time {
+	t, _ := zone.Resolve(wall, loc, data.GapShiftForward, data.FoldEarlier)
+	return t
+}
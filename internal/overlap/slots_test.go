@@ -0,0 +1,154 @@
+package overlap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func clock(hour, minute int) data.ClockTime {
+	return data.ClockTime(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+}
+
+func slotStarts(result data.SlotResult) []string {
+	starts := make([]string, len(result.Slots))
+	for i, s := range result.Slots {
+		starts[i] = s.Range.Start.UTC().Format(time.RFC3339)
+	}
+	return starts
+}
+
+func TestOverlapService_FindSlots(t *testing.T) {
+	busy := map[string][]data.DateRange{
+		"alice": {hours(9, 10), hours(13, 14)},
+		"bob":   {createDateRange("2025-07-01T10:00:00Z", "2025-07-01T10:30:00Z")},
+		"carol": {createDateRange("2025-07-01T11:15:00Z", "2025-07-01T12:00:00Z")},
+	}
+
+	testCases := []struct {
+		name     string
+		duration time.Duration
+		opts     data.SlotOptions
+		expected []string
+	}{
+		{"Every Slot", time.Hour, data.SlotOptions{}, []string{"2025-07-01T08:00:00Z", "2025-07-01T12:00:00Z", "2025-07-01T14:00:00Z"}},
+		{"Top K", time.Hour, data.SlotOptions{Limit: 2}, []string{"2025-07-01T08:00:00Z", "2025-07-01T12:00:00Z"}},
+		{"Short Slots Fit Between Meetings", 45 * time.Minute, data.SlotOptions{Limit: 3}, []string{"2025-07-01T08:00:00Z", "2025-07-01T10:30:00Z", "2025-07-01T12:00:00Z"}},
+		{"Working Hours", time.Hour, data.SlotOptions{WorkingHours: []data.WorkingHours{{Start: clock(9, 0), End: clock(17, 0)}}}, []string{"2025-07-01T12:00:00Z", "2025-07-01T14:00:00Z"}},
+		{"Padding Between Meetings", 45 * time.Minute, data.SlotOptions{OverlapOptions: data.OverlapOptions{Padding: padding(0, 15*time.Minute)}}, []string{"2025-07-01T08:00:00Z", "2025-07-01T12:15:00Z", "2025-07-01T14:15:00Z"}},
+		{"Nothing Long Enough", 4 * time.Hour, data.SlotOptions{}, []string{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := newTestService()
+
+			result, err := service.FindSlots(busy, data.Duration(tc.duration), hours(8, 16), tc.opts)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, slotStarts(result))
+			for _, s := range result.Slots {
+				assert.Equal(t, data.Duration(tc.duration), s.Range.Duration())
+				assert.False(t, s.Range.End.After(s.Free.End), "the slot lies within its free stretch")
+			}
+		})
+	}
+}
+
+func TestOverlapService_FindSlotsBoundaryModes(t *testing.T) {
+	busy := map[string][]data.DateRange{"alice": {hours(10, 11)}}
+
+	testCases := []struct {
+		boundary data.Boundary
+		expected []data.Slot
+	}{
+		{data.BoundaryClosedOpen, []data.Slot{
+			{Range: hours(9, 10), Free: hours(9, 10)},
+			{Range: hours(11, 12), Free: hours(11, 13)},
+		}},
+		{data.BoundaryClosed, []data.Slot{
+			{Range: bounded(hours(9, 10), data.BoundaryClosedOpen), Free: bounded(hours(9, 10), data.BoundaryClosedOpen)},
+			{Range: bounded(hours(11, 12), data.BoundaryOpenClosed), Free: bounded(hours(11, 13), data.BoundaryOpenClosed)},
+		}},
+		{data.BoundaryOpenClosed, []data.Slot{
+			{Range: hours(9, 10), Free: hours(9, 10)},
+			{Range: hours(11, 12), Free: hours(11, 13)},
+		}},
+		{data.BoundaryOpen, []data.Slot{
+			{Range: hours(9, 10), Free: bounded(hours(9, 10), data.BoundaryOpenClosed)},
+			{Range: bounded(hours(11, 12), data.BoundaryClosedOpen), Free: bounded(hours(11, 13), data.BoundaryClosedOpen)},
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.boundary), func(t *testing.T) {
+			service := newTestService()
+			opts := data.SlotOptions{OverlapOptions: data.OverlapOptions{Boundary: tc.boundary}}
+
+			result, err := service.FindSlots(busy, data.Duration(time.Hour), hours(9, 13), opts)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result.Slots, "no slot takes an instant alice is busy")
+		})
+	}
+}
+
+func TestOverlapService_FindSlotsWithCalendar(t *testing.T) {
+	service := newCalendarService()
+	// Thursday 2025-07-03 is fully booked, Friday is Independence Day and
+	// then comes the weekend.
+	busy := map[string][]data.DateRange{
+		"alice": {createDateRange("2025-07-03T13:00:00Z", "2025-07-03T21:00:00Z")},
+		"bob":   {createDateRange("2025-07-07T13:00:00Z", "2025-07-07T14:00:00Z")},
+	}
+	opts := data.SlotOptions{
+		WorkingHours: []data.WorkingHours{{Days: []string{"monday", "tuesday", "wednesday", "thursday", "friday"}, Start: clock(9, 0), End: clock(17, 0)}},
+		Calendar:     "US",
+		Limit:        1,
+	}
+
+	result, err := service.FindSlots(busy, data.Duration(time.Hour), createDateRange("2025-07-03T00:00:00Z", "2025-07-10T00:00:00Z"), opts)
+
+	require.NoError(t, err)
+	require.Len(t, result.Slots, 1)
+	assert.Equal(t, []string{"2025-07-07T14:00:00Z"}, slotStarts(result))
+	assert.Equal(t, "2025-07-07T10:00:00-04:00", result.Slots[0].Range.Start.Format(time.RFC3339), "slots are given in the calendar's zone")
+	assert.Equal(t, "2025-07-07T17:00:00-04:00", result.Slots[0].Free.End.Format(time.RFC3339))
+}
+
+func TestOverlapService_FindSlotsAcrossDST(t *testing.T) {
+	service := newTestService()
+	opts := data.SlotOptions{WorkingHours: []data.WorkingHours{{Start: clock(9, 0), End: clock(10, 0)}}, Zone: "America/New_York"}
+
+	result, err := service.FindSlots(nil, data.Duration(time.Hour), createDateRange("2025-03-08T00:00:00Z", "2025-03-10T00:00:00Z"), opts)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2025-03-08T14:00:00Z", "2025-03-09T13:00:00Z"}, slotStarts(result), "09:00 local moves with the clocks")
+}
+
+func TestOverlapService_FindSlotsErrors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		window data.DateRange
+		opts   data.SlotOptions
+		field  string
+	}{
+		{"Open Window", from("2025-07-01T00:00:00Z"), data.SlotOptions{}, "window"},
+		{"Unknown Calendar", hours(8, 16), data.SlotOptions{Calendar: "Atlantis"}, "calendar"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := newCalendarService()
+
+			_, err := service.FindSlots(nil, data.Duration(time.Hour), tc.window, tc.opts)
+
+			var fieldErr *FieldError
+			require.ErrorAs(t, err, &fieldErr)
+			assert.Equal(t, tc.field, fieldErr.Field)
+		})
+	}
+}
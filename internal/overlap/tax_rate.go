@@ -0,0 +1,125 @@
+package overlap
+
+import (
+	"sort"
+
+	"github.com/keshu12345/overlap-avalara/data"
+)
+
+// ValidateTaxRates reports, per jurisdiction, the rows whose periods overlap
+// with different rates, the rows that repeat another row's rate and period,
+// and the gaps in which the jurisdiction has no rate. Gaps are looked for
+// within window, or between the first start and the last end of the
+// jurisdiction's rows when window is nil. Rows that overlap with the same rate
+// but different periods are not a problem, since either gives the same rate.
+func (os *overlapService) ValidateTaxRates(rows []data.TaxRateRow, window *data.DateRange, opts data.OverlapOptions) data.TaxRateReport {
+	os.Logger.Info("Validating tax rate periods with overlapservice")
+	boundary := os.boundary(opts)
+
+	byJurisdiction := make(map[string][]int)
+	for i, row := range rows {
+		byJurisdiction[row.Jurisdiction] = append(byJurisdiction[row.Jurisdiction], i)
+	}
+	names := make([]string, 0, len(byJurisdiction))
+	for name := range byJurisdiction {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	report := data.TaxRateReport{Valid: true, Jurisdictions: make([]data.JurisdictionReport, 0, len(names)), Boundary: boundary}
+	for _, name := range names {
+		jr := validateJurisdiction(name, rows, byJurisdiction[name], window, opts, boundary)
+		report.Valid = report.Valid && jr.Valid
+		report.Jurisdictions = append(report.Jurisdictions, jr)
+	}
+	return report
+}
+
+// validateJurisdiction checks the rows at indexes, which all belong to the
+// jurisdiction name and are in request order.
+func validateJurisdiction(name string, rows []data.TaxRateRow, indexes []int, window *data.DateRange, opts data.OverlapOptions, boundary data.Boundary) data.JurisdictionReport {
+	jr := data.JurisdictionReport{
+		Jurisdiction: name,
+		Rows:         indexes,
+		Conflicts:    make([]data.RateConflict, 0),
+		Gaps:         make([]data.RateGap, 0),
+		Duplicates:   make([]data.RateDuplicate, 0),
+	}
+
+	padded := make([]data.DateRange, len(indexes))
+	for k, i := range indexes {
+		padded[k] = pad(rows[i].Range, opts)
+	}
+	for _, p := range times.Overlaps(toIntervals(padded), boundary) {
+		first, second := rows[indexes[p.First]], rows[indexes[p.Second]]
+		if first.Rate.Cmp(*second.Rate) == 0 {
+			if sameRange(first.Range, second.Range) {
+				jr.Duplicates = append(jr.Duplicates, data.RateDuplicate{
+					FirstIndex:  indexes[p.First],
+					FirstID:     first.ID,
+					SecondIndex: indexes[p.Second],
+					SecondID:    second.ID,
+					Range:       first.Range,
+					Rate:        *first.Rate,
+				})
+			}
+			continue
+		}
+		r := fromInterval(p.Intersection)
+		if !longEnough(r.Duration(), padded[p.First], padded[p.Second], opts.MinOverlap) {
+			continue
+		}
+		jr.Conflicts = append(jr.Conflicts, data.RateConflict{
+			FirstIndex:   indexes[p.First],
+			FirstID:      first.ID,
+			FirstRate:    *first.Rate,
+			SecondIndex:  indexes[p.Second],
+			SecondID:     second.ID,
+			SecondRate:   *second.Rate,
+			Intersection: r,
+			Duration:     r.Duration(),
+		})
+	}
+
+	// Gaps are left by the rows as given; padding only widens them to find
+	// conflicts.
+	raw := make([]data.DateRange, len(indexes))
+	for k, i := range indexes {
+		raw[k] = rows[i].Range
+	}
+	target := raw[0]
+	for _, r := range raw[1:] {
+		target = data.DateRange{Start: earlierStart(target.Start, r.Start), End: laterEnd(target.End, r.End)}
+	}
+	if window != nil {
+		target = *window
+	}
+	// The rows that end where a gap starts and start where it ends, by instant.
+	endsAt, startsAt := make(map[int64]int), make(map[int64]int)
+	for k := len(raw) - 1; k >= 0; k-- {
+		if raw[k].HasEnd() {
+			endsAt[raw[k].End.UnixNano()] = indexes[k]
+		}
+		if raw[k].HasStart() {
+			startsAt[raw[k].Start.UnixNano()] = indexes[k]
+		}
+	}
+	for _, g := range complement(raw, target, boundary) {
+		gap := data.RateGap{Range: g, Duration: g.Duration()}
+		if i, ok := endsAt[g.Start.UnixNano()]; g.HasStart() && ok {
+			gap.BeforeIndex, gap.BeforeID = &i, rows[i].ID
+		}
+		if i, ok := startsAt[g.End.UnixNano()]; g.HasEnd() && ok {
+			gap.AfterIndex, gap.AfterID = &i, rows[i].ID
+		}
+		jr.Gaps = append(jr.Gaps, gap)
+	}
+
+	jr.Valid = len(jr.Conflicts) == 0 && len(jr.Gaps) == 0 && len(jr.Duplicates) == 0
+	return jr
+}
+
+// sameRange reports whether r1 and r2 have the same bounds.
+func sameRange(r1, r2 data.DateRange) bool {
+	return compareStarts(r1.Start, r2.Start) == 0 && compareEnds(r1.End, r2.End) == 0
+}
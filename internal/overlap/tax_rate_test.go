@@ -0,0 +1,123 @@
+package overlap
+
+import (
+	"testing"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func rateRow(id, jurisdiction, rate string, r data.DateRange) data.TaxRateRow {
+	return data.TaxRateRow{ID: id, Jurisdiction: jurisdiction, Rate: ptr(decimal(rate)), Range: r}
+}
+
+func taxRateRows() []data.TaxRateRow {
+	return []data.TaxRateRow{
+		rateRow("ca-1", "CA", "7.25", createDateRange("2024-01-01T00:00:00Z", "2025-01-01T00:00:00Z")),
+		rateRow("ca-2", "CA", "7.5", from("2024-12-01T00:00:00Z")),
+		rateRow("ny-1", "NY", "4", createDateRange("2024-01-01T00:00:00Z", "2024-07-01T00:00:00Z")),
+		rateRow("ny-2", "NY", "4.5", createDateRange("2024-08-01T00:00:00Z", "2025-01-01T00:00:00Z")),
+		rateRow("ny-3", "NY", "4.50", createDateRange("2024-08-01T00:00:00Z", "2025-01-01T00:00:00Z")),
+		rateRow("tx-1", "TX", "6.25", createDateRange("2024-01-01T00:00:00Z", "2024-07-01T00:00:00Z")),
+		rateRow("tx-2", "TX", "6.25", createDateRange("2024-03-01T00:00:00Z", "2025-01-01T00:00:00Z")),
+	}
+}
+
+func TestOverlapService_ValidateTaxRates(t *testing.T) {
+	service := newTestService()
+
+	report := service.ValidateTaxRates(taxRateRows(), nil, data.OverlapOptions{})
+
+	assert.False(t, report.Valid)
+	assert.Equal(t, data.BoundaryClosedOpen, report.Boundary)
+	require.Len(t, report.Jurisdictions, 3)
+
+	ca := report.Jurisdictions[0]
+	assert.Equal(t, "CA", ca.Jurisdiction)
+	assert.False(t, ca.Valid)
+	assert.Equal(t, []int{0, 1}, ca.Rows)
+	overlap := createDateRange("2024-12-01T00:00:00Z", "2025-01-01T00:00:00Z")
+	assert.Equal(t, []data.RateConflict{{
+		FirstIndex: 0, FirstID: "ca-1", FirstRate: decimal("7.25"),
+		SecondIndex: 1, SecondID: "ca-2", SecondRate: decimal("7.5"),
+		Intersection: overlap, Duration: overlap.Duration(),
+	}}, ca.Conflicts)
+	assert.Empty(t, ca.Gaps)
+	assert.Empty(t, ca.Duplicates)
+
+	ny := report.Jurisdictions[1]
+	assert.Equal(t, "NY", ny.Jurisdiction)
+	assert.False(t, ny.Valid)
+	assert.Empty(t, ny.Conflicts, "a duplicate is not also a conflict")
+	gap := createDateRange("2024-07-01T00:00:00Z", "2024-08-01T00:00:00Z")
+	assert.Equal(t, []data.RateGap{{Range: gap, Duration: gap.Duration(), BeforeIndex: ptr(2), BeforeID: "ny-1", AfterIndex: ptr(3), AfterID: "ny-2"}}, ny.Gaps)
+	require.Len(t, ny.Duplicates, 1)
+	assert.Equal(t, 3, ny.Duplicates[0].FirstIndex)
+	assert.Equal(t, 4, ny.Duplicates[0].SecondIndex)
+	assert.Equal(t, "4.5", ny.Duplicates[0].Rate.String())
+
+	tx := report.Jurisdictions[2]
+	assert.Equal(t, "TX", tx.Jurisdiction)
+	assert.True(t, tx.Valid, "rows that overlap with the same rate agree")
+}
+
+func TestOverlapService_ValidateTaxRatesWithWindow(t *testing.T) {
+	service := newTestService()
+	window := createDateRange("2023-07-01T00:00:00Z", "2026-01-01T00:00:00Z")
+
+	report := service.ValidateTaxRates(taxRateRows(), &window, data.OverlapOptions{})
+
+	ca, tx := report.Jurisdictions[0], report.Jurisdictions[2]
+	lead := createDateRange("2023-07-01T00:00:00Z", "2024-01-01T00:00:00Z")
+	assert.Equal(t, []data.RateGap{{Range: lead, Duration: lead.Duration(), AfterIndex: ptr(0), AfterID: "ca-1"}}, ca.Gaps, "ca-2 runs past the window")
+
+	tail := createDateRange("2025-01-01T00:00:00Z", "2026-01-01T00:00:00Z")
+	require.Len(t, tx.Gaps, 2)
+	assert.Equal(t, data.RateGap{Range: tail, Duration: tail.Duration(), BeforeIndex: ptr(6), BeforeID: "tx-2"}, tx.Gaps[1])
+	assert.False(t, tx.Valid)
+}
+
+func TestOverlapService_ValidateTaxRatesClean(t *testing.T) {
+	service := newTestService()
+	rows := []data.TaxRateRow{
+		rateRow("", "WA", "6.5", createDateRange("2024-01-01T00:00:00Z", "2025-01-01T00:00:00Z")),
+		rateRow("", "WA", "6.6", from("2025-01-01T00:00:00Z")),
+	}
+
+	report := service.ValidateTaxRates(rows, nil, data.OverlapOptions{})
+
+	assert.True(t, report.Valid)
+	assert.Equal(t, []data.JurisdictionReport{{
+		Jurisdiction: "WA",
+		Valid:        true,
+		Rows:         []int{0, 1},
+		Conflicts:    []data.RateConflict{},
+		Gaps:         []data.RateGap{},
+		Duplicates:   []data.RateDuplicate{},
+	}}, report.Jurisdictions)
+
+	closed := service.ValidateTaxRates(rows, nil, data.OverlapOptions{Boundary: data.BoundaryClosed})
+	assert.False(t, closed.Valid, "under [] the rows share the instant they meet")
+	assert.Len(t, closed.Jurisdictions[0].Conflicts, 1)
+}
+
+func TestOverlapService_ValidateTaxRatesOpenBoundary(t *testing.T) {
+	service := newTestService()
+	rows := []data.TaxRateRow{
+		rateRow("ca-1", "CA", "7", createDateRange("2024-01-01T00:00:00Z", "2024-06-01T00:00:00Z")),
+		rateRow("ca-2", "CA", "8", createDateRange("2024-06-01T00:00:00Z", "2025-01-01T00:00:00Z")),
+	}
+
+	report := service.ValidateTaxRates(rows, nil, data.OverlapOptions{Boundary: data.BoundaryOpen})
+
+	assert.False(t, report.Valid, "under () neither row holds the instant they meet")
+	ca := report.Jurisdictions[0]
+	assert.Empty(t, ca.Conflicts)
+	june := mustParseTime("2024-06-01T00:00:00Z")
+	assert.Equal(t, []data.RateGap{{
+		Range:       data.DateRange{Start: june, End: june, Boundary: data.BoundaryClosed},
+		BeforeIndex: ptr(0), BeforeID: "ca-1",
+		AfterIndex: ptr(1), AfterID: "ca-2",
+	}}, ca.Gaps)
+}
@@ -0,0 +1,90 @@
+package overlap
+
+import (
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/keshu12345/overlap-avalara/internal/zone"
+)
+
+// IntersectZoned resolves two wall-clock ranges to instants, each in its own
+// zone, and intersects them. Every time in the result is given in the output
+// zone.
+func (os *overlapService) IntersectZoned(r1, r2 data.ZonedRange, opts data.ZoneOptions) (data.ZonedOverlapResult, error) {
+	os.Logger.Info("Computing zoned time range intersection with overlapservice")
+
+	outputZone := opts.OutputZone
+	if outputZone == "" {
+		outputZone = r1.Zone
+	}
+	out, err := zone.Load(outputZone)
+	if err != nil {
+		return data.ZonedOverlapResult{}, &FieldError{Field: "output_zone", Message: err.Error()}
+	}
+	a, err := resolveRange("range1", r1, opts)
+	if err != nil {
+		return data.ZonedOverlapResult{}, err
+	}
+	b, err := resolveRange("range2", r2, opts)
+	if err != nil {
+		return data.ZonedOverlapResult{}, err
+	}
+
+	result := intersectPadded(a, b, opts.OverlapOptions, os.boundary(opts.OverlapOptions))
+	return data.ZonedOverlapResult{
+		OverlapResult: resultInZone(result, out),
+		Range1:        inZone(a, out),
+		Range2:        inZone(b, out),
+		OutputZone:    out.String(),
+	}, nil
+}
+
+// resolveRange turns a zoned range into instants, reporting problems against
+// the fields of the range called name.
+func resolveRange(name string, r data.ZonedRange, opts data.ZoneOptions) (data.DateRange, error) {
+	loc, err := zone.Load(r.Zone)
+	if err != nil {
+		return data.DateRange{}, &FieldError{Field: name + ".zone", Message: err.Error()}
+	}
+
+	var resolved data.DateRange
+	if !r.Start.IsZero() {
+		if resolved.Start, err = zone.Resolve(r.Start.Wall(), loc, opts.Nonexistent, opts.Ambiguous); err != nil {
+			return resolved, &FieldError{Field: name + ".start", Message: err.Error()}
+		}
+	}
+	if !r.End.IsZero() {
+		if resolved.End, err = zone.Resolve(r.End.Wall(), loc, opts.Nonexistent, opts.Ambiguous); err != nil {
+			return resolved, &FieldError{Field: name + ".end", Message: err.Error()}
+		}
+	}
+	if endBeforeStart(resolved.End, resolved.Start) {
+		// Only possible around a DST gap: 02:30 shifted forward to 03:30 lies
+		// after an end of 03:15.
+		return resolved, &FieldError{Field: name + ".end", Message: "falls before start once resolved in " + loc.String()}
+	}
+	return resolved, nil
+}
+
+// resultInZone gives the windows of result in loc.
+func resultInZone(result data.OverlapResult, loc *time.Location) data.OverlapResult {
+	if result.Intersection != nil {
+		r := inZone(*result.Intersection, loc)
+		result.Intersection = &r
+	}
+	if result.RawIntersection != nil {
+		r := inZone(*result.RawIntersection, loc)
+		result.RawIntersection = &r
+	}
+	return result
+}
+
+func inZone(r data.DateRange, loc *time.Location) data.DateRange {
+	if r.HasStart() {
+		r.Start = r.Start.In(loc)
+	}
+	if r.HasEnd() {
+		r.End = r.End.In(loc)
+	}
+	return r
+}
@@ -0,0 +1,168 @@
+package overlap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func local(s string) data.LocalDateTime {
+	t, err := time.Parse("2006-01-02T15:04:05", s)
+	if err != nil {
+		panic(err)
+	}
+	return data.LocalDateTime(t)
+}
+
+func zoned(start, end, zone string) data.ZonedRange {
+	return data.ZonedRange{Start: local(start), End: local(end), Zone: zone}
+}
+
+func TestOverlapService_IntersectZoned(t *testing.T) {
+	testCases := []struct {
+		name             string
+		range1, range2   data.ZonedRange
+		opts             data.ZoneOptions
+		expectedOverlap  bool
+		expectedDuration time.Duration
+		expectedStart    string
+		expectedZone     string
+	}{
+		{
+			name:             "Same Wall Clock In Two Zones",
+			range1:           zoned("2025-07-01T09:00:00", "2025-07-01T17:00:00", "America/New_York"),
+			range2:           zoned("2025-07-01T14:00:00", "2025-07-01T18:00:00", "Europe/London"),
+			expectedOverlap:  true,
+			expectedDuration: 4 * time.Hour,
+			expectedStart:    "2025-07-01T09:00:00-04:00",
+			expectedZone:     "America/New_York",
+		},
+		{
+			name:             "Output Zone",
+			range1:           zoned("2025-07-01T09:00:00", "2025-07-01T17:00:00", "America/New_York"),
+			range2:           zoned("2025-07-01T14:00:00", "2025-07-01T18:00:00", "Europe/London"),
+			opts:             data.ZoneOptions{OutputZone: "Asia/Tokyo"},
+			expectedOverlap:  true,
+			expectedDuration: 4 * time.Hour,
+			expectedStart:    "2025-07-01T22:00:00+09:00",
+			expectedZone:     "Asia/Tokyo",
+		},
+		{
+			name:             "Spring Forward Night Is An Hour Short",
+			range1:           zoned("2025-03-09T00:00:00", "2025-03-09T06:00:00", "America/New_York"),
+			range2:           zoned("2025-03-09T00:00:00", "2025-03-09T06:00:00", "UTC"),
+			opts:             data.ZoneOptions{OutputZone: "UTC"},
+			expectedOverlap:  true,
+			expectedDuration: time.Hour,
+			expectedStart:    "2025-03-09T05:00:00Z",
+			expectedZone:     "UTC",
+		},
+		{
+			name:             "Working Day Across The Change",
+			range1:           zoned("2025-03-09T09:00:00", "2025-03-09T17:00:00", "America/New_York"),
+			range2:           zoned("2025-03-09T09:00:00", "2025-03-09T17:00:00", "America/New_York"),
+			expectedOverlap:  true,
+			expectedDuration: 8 * time.Hour,
+			expectedStart:    "2025-03-09T09:00:00-04:00",
+			expectedZone:     "America/New_York",
+		},
+		{
+			name:             "Repeated Hour Picks The Later Instant",
+			range1:           zoned("2025-11-02T01:30:00", "2025-11-02T03:00:00", "America/New_York"),
+			range2:           zoned("2025-11-02T06:00:00", "2025-11-02T07:00:00", "UTC"),
+			opts:             data.ZoneOptions{Ambiguous: data.FoldLater},
+			expectedOverlap:  true,
+			expectedDuration: 30 * time.Minute,
+			expectedStart:    "2025-11-02T01:30:00-05:00",
+			expectedZone:     "America/New_York",
+		},
+		{
+			name:            "Repeated Hour Picks The Earlier Instant",
+			range1:          zoned("2025-11-02T01:30:00", "2025-11-02T01:45:00", "America/New_York"),
+			range2:          zoned("2025-11-02T06:00:00", "2025-11-02T07:00:00", "UTC"),
+			expectedOverlap: false,
+			expectedZone:    "America/New_York",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := newTestService().IntersectZoned(tc.range1, tc.range2, tc.opts)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedOverlap, result.Overlap)
+			assert.Equal(t, tc.expectedZone, result.OutputZone)
+			assert.Equal(t, tc.expectedZone, result.Range1.Start.Location().String())
+			if tc.expectedOverlap {
+				require.NotNil(t, result.Intersection)
+				assert.Equal(t, data.Duration(tc.expectedDuration), result.Duration)
+				assert.Equal(t, tc.expectedStart, result.Intersection.Start.Format(time.RFC3339))
+			}
+		})
+	}
+}
+
+func TestOverlapService_IntersectZonedOpenEnded(t *testing.T) {
+	r1 := data.ZonedRange{Start: local("2025-01-01T00:00:00"), Zone: "Europe/Berlin"}
+	r2 := zoned("2030-06-01T00:00:00", "2030-06-02T00:00:00", "UTC")
+
+	result, err := newTestService().IntersectZoned(r1, r2, data.ZoneOptions{})
+
+	require.NoError(t, err)
+	assert.True(t, result.Overlap)
+	assert.False(t, result.Range1.HasEnd())
+	assert.Equal(t, "2030-06-01T02:00:00+02:00", result.Intersection.Start.Format(time.RFC3339))
+}
+
+func TestOverlapService_IntersectZonedErrors(t *testing.T) {
+	valid := zoned("2025-07-01T09:00:00", "2025-07-01T17:00:00", "UTC")
+	testCases := []struct {
+		name          string
+		range1        data.ZonedRange
+		opts          data.ZoneOptions
+		expectedField string
+	}{
+		{
+			name:          "Skipped Time Rejected",
+			range1:        zoned("2025-03-09T02:30:00", "2025-03-09T04:00:00", "America/New_York"),
+			opts:          data.ZoneOptions{Nonexistent: data.GapReject},
+			expectedField: "range1.start",
+		},
+		{
+			name:          "Repeated Time Rejected",
+			range1:        zoned("2025-11-02T00:00:00", "2025-11-02T01:30:00", "America/New_York"),
+			opts:          data.ZoneOptions{Ambiguous: data.FoldReject},
+			expectedField: "range1.end",
+		},
+		{
+			name:          "Shifted Start Passes The End",
+			range1:        zoned("2025-03-09T02:30:00", "2025-03-09T03:15:00", "America/New_York"),
+			expectedField: "range1.end",
+		},
+		{
+			name:          "Unknown Zone",
+			range1:        zoned("2025-07-01T09:00:00", "2025-07-01T17:00:00", "Nowhere/Special"),
+			opts:          data.ZoneOptions{OutputZone: "UTC"},
+			expectedField: "range1.zone",
+		},
+		{
+			name:          "Unknown Output Zone",
+			range1:        valid,
+			opts:          data.ZoneOptions{OutputZone: "Local"},
+			expectedField: "output_zone",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := newTestService().IntersectZoned(tc.range1, valid, tc.opts)
+
+			var fieldErr *FieldError
+			require.ErrorAs(t, err, &fieldErr)
+			assert.Equal(t, tc.expectedField, fieldErr.Field)
+		})
+	}
+}
@@ -0,0 +1,287 @@
+package recurrence
+
+import (
+	"sort"
+	"time"
+)
+
+// lastYear stops rules whose BY parts never match, such as the 30th of February.
+const lastYear = 9999
+
+// maxCandidates bounds the work of one expansion: every period a rule goes
+// through counts once, or once for each instance it holds. Instances before
+// the range and those a caller drops count too, so neither a rule with
+// thousands of instances a day nor a COUNT that starts centuries before the
+// range can keep an expansion busy.
+const maxCandidates = 1000000
+
+// each yields the start of every instance of r, in order, until yield returns
+// false, the rule ends, or the instances pass limit (zero for no limit).
+// DTSTART always counts as the first instance, as RFC 5545 requires. Without
+// COUNT, instances need not be counted from DTSTART, so the periods well
+// before from are skipped. each reports false when it gave up after
+// maxCandidates.
+func (r Rule) each(dtstart, from, limit time.Time, yield func(time.Time) bool) bool {
+	if !yield(dtstart) {
+		return true
+	}
+	n, candidates := 1, 1
+	p := 0
+	if r.Count == 0 && !from.IsZero() {
+		p = r.periodBefore(dtstart, from)
+	}
+	perDay := len(orDefault(r.ByHour, 0)) * len(orDefault(r.ByMinute, 0)) * len(orDefault(r.BySecond, 0))
+	for ; ; p++ {
+		first, days := r.period(dtstart, p)
+		if first.Year() > lastYear || (!limit.IsZero() && first.After(limit)) {
+			return true
+		}
+		if candidates += max(len(days)*perDay, 1); candidates > maxCandidates {
+			return false
+		}
+		for _, t := range r.setPositions(r.times(dtstart, days)) {
+			if !t.After(dtstart) {
+				continue
+			}
+			if (r.Count > 0 && n >= r.Count) || (!r.Until.IsZero() && t.After(r.Until)) {
+				return true
+			}
+			if !yield(t) {
+				return true
+			}
+			n++
+		}
+	}
+}
+
+// periodBefore returns the index of the period before the one holding t, or
+// zero when t is not past the period holding dtstart.
+func (r Rule) periodBefore(dtstart, t time.Time) int {
+	t = t.In(dtstart.Location())
+	var units int
+	switch r.Freq {
+	case Daily:
+		units = civilDays(dtstart, t)
+	case Weekly:
+		offset := (int(dtstart.Weekday()) - int(r.WeekStart) + 7) % 7
+		units = (civilDays(dtstart, t) + offset) / 7
+	case Monthly:
+		units = (t.Year()-dtstart.Year())*12 + int(t.Month()) - int(dtstart.Month())
+	default:
+		units = t.Year() - dtstart.Year()
+	}
+	return max(units/r.Interval-1, 0)
+}
+
+// civilDays counts the calendar days from the day of a to the day of b.
+func civilDays(a, b time.Time) int {
+	ya, ma, da := a.Date()
+	yb, mb, db := b.Date()
+	return int((time.Date(yb, mb, db, 0, 0, 0, 0, time.UTC).Unix() - time.Date(ya, ma, da, 0, 0, 0, 0, time.UTC).Unix()) / (24 * 60 * 60))
+}
+
+// period returns the first day of the p-th period after the one holding
+// dtstart, along with the days of that period that the rule selects.
+func (r Rule) period(dtstart time.Time, p int) (time.Time, []time.Time) {
+	y, m, d := dtstart.Date()
+	loc := dtstart.Location()
+	step := p * r.Interval
+
+	switch r.Freq {
+	case Daily:
+		day := time.Date(y, m, d+step, 0, 0, 0, 0, loc)
+		if r.matchesDaily(day) {
+			return day, []time.Time{day}
+		}
+		return day, nil
+	case Weekly:
+		offset := (int(dtstart.Weekday()) - int(r.WeekStart) + 7) % 7
+		first := time.Date(y, m, d-offset+7*step, 0, 0, 0, 0, loc)
+		return first, r.weekDays(dtstart, first)
+	case Monthly:
+		first := time.Date(y, m+time.Month(step), 1, 0, 0, 0, 0, loc)
+		if len(r.ByMonth) > 0 && !contains(r.ByMonth, int(first.Month())) {
+			return first, nil
+		}
+		return first, r.monthDays(dtstart, first)
+	default:
+		first := time.Date(y+step, time.January, 1, 0, 0, 0, 0, loc)
+		return first, r.yearDays(dtstart, first)
+	}
+}
+
+func (r Rule) matchesDaily(day time.Time) bool {
+	if len(r.ByMonth) > 0 && !contains(r.ByMonth, int(day.Month())) {
+		return false
+	}
+	if len(r.ByMonthDay) > 0 && !matchesMonthDay(r.ByMonthDay, day) {
+		return false
+	}
+	if len(r.ByDay) > 0 && !r.matchesByDay(day, 0, 0) {
+		return false
+	}
+	return true
+}
+
+func (r Rule) weekDays(dtstart, first time.Time) []time.Time {
+	days := make([]time.Time, 0, 7)
+	for i := 0; i < 7; i++ {
+		day := first.AddDate(0, 0, i)
+		if len(r.ByMonth) > 0 && !contains(r.ByMonth, int(day.Month())) {
+			continue
+		}
+		if len(r.ByDay) == 0 && day.Weekday() != dtstart.Weekday() {
+			continue
+		}
+		if len(r.ByDay) > 0 && !r.matchesByDay(day, 0, 0) {
+			continue
+		}
+		days = append(days, day)
+	}
+	return days
+}
+
+// monthDays selects the days of the month starting at first. Without BYMONTHDAY
+// or BYDAY the rule repeats the day of the month of dtstart.
+func (r Rule) monthDays(dtstart, first time.Time) []time.Time {
+	length := daysIn(first.Year(), first.Month())
+	if len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 {
+		if dtstart.Day() > length {
+			return nil
+		}
+		return []time.Time{first.AddDate(0, 0, dtstart.Day()-1)}
+	}
+	return r.scopeDays(first, length)
+}
+
+// yearDays selects the days of the year starting at first. BYDAY ordinals count
+// within the year unless BYMONTH or BYMONTHDAY narrow the rule to months.
+func (r Rule) yearDays(dtstart, first time.Time) []time.Time {
+	if len(r.ByMonth) == 0 && len(r.ByMonthDay) == 0 && len(r.ByDay) > 0 {
+		length := 365
+		if daysIn(first.Year(), time.February) == 29 {
+			length = 366
+		}
+		return r.scopeDays(first, length)
+	}
+
+	months := r.ByMonth
+	if len(months) == 0 {
+		if len(r.ByMonthDay) == 0 {
+			months = []int{int(dtstart.Month())}
+		} else {
+			months = []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+		}
+	}
+	sorted := append([]int(nil), months...)
+	sort.Ints(sorted)
+
+	var days []time.Time
+	for _, m := range sorted {
+		days = append(days, r.monthDays(dtstart, first.AddDate(0, m-1, 0))...)
+	}
+	return days
+}
+
+// scopeDays filters the length days starting at first by BYMONTHDAY and BYDAY,
+// with BYDAY ordinals counted within those days.
+func (r Rule) scopeDays(first time.Time, length int) []time.Time {
+	days := make([]time.Time, 0)
+	for i := 0; i < length; i++ {
+		day := first.AddDate(0, 0, i)
+		if len(r.ByMonth) > 0 && !contains(r.ByMonth, int(day.Month())) {
+			continue
+		}
+		if len(r.ByMonthDay) > 0 && !matchesMonthDay(r.ByMonthDay, day) {
+			continue
+		}
+		if len(r.ByDay) > 0 && !r.matchesByDay(day, i, length) {
+			continue
+		}
+		days = append(days, day)
+	}
+	return days
+}
+
+// matchesByDay reports whether day, the index-th of length days in its scope,
+// matches a BYDAY entry. Ordinals count weekdays from either end of the scope.
+func (r Rule) matchesByDay(day time.Time, index, length int) bool {
+	for _, wd := range r.ByDay {
+		if wd.Weekday != day.Weekday() {
+			continue
+		}
+		if wd.N == 0 || wd.N == index/7+1 || wd.N == -((length-1-index)/7+1) {
+			return true
+		}
+	}
+	return false
+}
+
+// times combines the selected days with BYHOUR, BYMINUTE and BYSECOND, which
+// default to the time of day of dtstart.
+func (r Rule) times(dtstart time.Time, days []time.Time) []time.Time {
+	hours := orDefault(r.ByHour, dtstart.Hour())
+	minutes := orDefault(r.ByMinute, dtstart.Minute())
+	seconds := orDefault(r.BySecond, dtstart.Second())
+
+	set := make([]time.Time, 0, len(days)*len(hours)*len(minutes)*len(seconds))
+	for _, day := range days {
+		y, m, d := day.Date()
+		for _, h := range hours {
+			for _, mi := range minutes {
+				for _, s := range seconds {
+					set = append(set, time.Date(y, m, d, h, mi, s, dtstart.Nanosecond(), dtstart.Location()))
+				}
+			}
+		}
+	}
+	sort.Slice(set, func(i, j int) bool { return set[i].Before(set[j]) })
+	return set
+}
+
+// setPositions applies BYSETPOS to the instances of one period.
+func (r Rule) setPositions(set []time.Time) []time.Time {
+	if len(r.BySetPos) == 0 || len(set) == 0 {
+		return set
+	}
+	picked := make([]time.Time, 0, len(r.BySetPos))
+	for i, t := range set {
+		for _, pos := range r.BySetPos {
+			if pos == i+1 || pos == i-len(set) {
+				picked = append(picked, t)
+				break
+			}
+		}
+	}
+	return picked
+}
+
+func matchesMonthDay(monthDays []int, day time.Time) bool {
+	length := daysIn(day.Year(), day.Month())
+	for _, md := range monthDays {
+		if md == day.Day() || (md < 0 && length+1+md == day.Day()) {
+			return true
+		}
+	}
+	return false
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func contains(list []int, v int) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+func orDefault(list []int, v int) []int {
+	if len(list) > 0 {
+		return list
+	}
+	return []int{v}
+}
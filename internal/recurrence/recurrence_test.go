@@ -0,0 +1,252 @@
+package recurrence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustParseTime(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func mustParseRule(s string) *Rule {
+	r, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return &r
+}
+
+func starts(occurrences []Occurrence) []string {
+	out := make([]string, 0, len(occurrences))
+	for _, o := range occurrences {
+		out = append(out, o.Start.Format(time.RFC3339))
+	}
+	return out
+}
+
+func TestParse(t *testing.T) {
+	r, err := Parse("RRULE:FREQ=MONTHLY;INTERVAL=3;BYDAY=MO,-1FR;BYSETPOS=1;COUNT=4;WKST=SU")
+	require.NoError(t, err)
+	assert.Equal(t, Monthly, r.Freq)
+	assert.Equal(t, 3, r.Interval)
+	assert.Equal(t, 4, r.Count)
+	assert.Equal(t, []WeekdayNum{{Weekday: time.Monday}, {Weekday: time.Friday, N: -1}}, r.ByDay)
+	assert.Equal(t, []int{1}, r.BySetPos)
+	assert.Equal(t, time.Sunday, r.WeekStart)
+
+	r, err = Parse("FREQ=DAILY;UNTIL=20250131")
+	require.NoError(t, err)
+	assert.Equal(t, mustParseTime("2025-01-31T23:59:59Z"), r.Until)
+}
+
+func TestParse_Errors(t *testing.T) {
+	testCases := []struct {
+		name string
+		rule string
+	}{
+		{name: "Empty", rule: ""},
+		{name: "Missing Frequency", rule: "INTERVAL=2"},
+		{name: "Unsupported Frequency", rule: "FREQ=SECONDLY"},
+		{name: "Unsupported Part", rule: "FREQ=YEARLY;BYWEEKNO=20"},
+		{name: "Malformed Part", rule: "FREQ=DAILY;COUNT"},
+		{name: "Repeated Part", rule: "FREQ=DAILY;FREQ=WEEKLY"},
+		{name: "Zero Interval", rule: "FREQ=DAILY;INTERVAL=0"},
+		{name: "Count And Until", rule: "FREQ=DAILY;COUNT=2;UNTIL=20250101"},
+		{name: "Bad Weekday", rule: "FREQ=WEEKLY;BYDAY=XX"},
+		{name: "Ordinal On Weekly", rule: "FREQ=WEEKLY;BYDAY=1MO"},
+		{name: "Month Out Of Range", rule: "FREQ=YEARLY;BYMONTH=13"},
+		{name: "Month Day Out Of Range", rule: "FREQ=MONTHLY;BYMONTHDAY=-32"},
+		{name: "Bad Until", rule: "FREQ=DAILY;UNTIL=tomorrow"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse(tc.rule)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestExpand(t *testing.T) {
+	testCases := []struct {
+		name     string
+		series   Series
+		from, to string
+		expected []string
+	}{
+		{
+			name:   "Every Monday And Wednesday",
+			series: Series{Start: mustParseTime("2025-07-07T09:00:00Z"), Rule: mustParseRule("FREQ=WEEKLY;BYDAY=MO,WE;COUNT=5")},
+			expected: []string{
+				"2025-07-07T09:00:00Z", "2025-07-09T09:00:00Z", "2025-07-14T09:00:00Z",
+				"2025-07-16T09:00:00Z", "2025-07-21T09:00:00Z",
+			},
+		},
+		{
+			name:   "First Business Day Of Each Quarter",
+			series: Series{Start: mustParseTime("2025-01-01T09:00:00Z"), Rule: mustParseRule("FREQ=MONTHLY;BYMONTH=1,4,7,10;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=1;COUNT=5")},
+			expected: []string{
+				"2025-01-01T09:00:00Z", "2025-04-01T09:00:00Z", "2025-07-01T09:00:00Z",
+				"2025-10-01T09:00:00Z", "2026-01-01T09:00:00Z",
+			},
+		},
+		{
+			name:   "Last Friday Of The Month",
+			series: Series{Start: mustParseTime("2025-01-31T17:00:00Z"), Rule: mustParseRule("FREQ=MONTHLY;BYDAY=-1FR;COUNT=3")},
+			expected: []string{
+				"2025-01-31T17:00:00Z", "2025-02-28T17:00:00Z", "2025-03-28T17:00:00Z",
+			},
+		},
+		{
+			name:   "Last Day Of The Month",
+			series: Series{Start: mustParseTime("2024-01-31T00:00:00Z"), Rule: mustParseRule("FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=3")},
+			expected: []string{
+				"2024-01-31T00:00:00Z", "2024-02-29T00:00:00Z", "2024-03-31T00:00:00Z",
+			},
+		},
+		{
+			name:   "Skips Months Without The Day",
+			series: Series{Start: mustParseTime("2025-01-31T00:00:00Z"), Rule: mustParseRule("FREQ=MONTHLY;COUNT=3")},
+			expected: []string{
+				"2025-01-31T00:00:00Z", "2025-03-31T00:00:00Z", "2025-05-31T00:00:00Z",
+			},
+		},
+		{
+			name:   "Leap Day Every Four Years",
+			series: Series{Start: mustParseTime("2024-02-29T00:00:00Z"), Rule: mustParseRule("FREQ=YEARLY;COUNT=2")},
+			expected: []string{
+				"2024-02-29T00:00:00Z", "2028-02-29T00:00:00Z",
+			},
+		},
+		{
+			name:   "Thanksgiving",
+			series: Series{Start: mustParseTime("2025-11-27T00:00:00Z"), Rule: mustParseRule("FREQ=YEARLY;BYMONTH=11;BYDAY=4TH;COUNT=2")},
+			expected: []string{
+				"2025-11-27T00:00:00Z", "2026-11-26T00:00:00Z",
+			},
+		},
+		{
+			name:   "Every Other Day Until",
+			series: Series{Start: mustParseTime("2025-07-01T08:00:00Z"), Rule: mustParseRule("FREQ=DAILY;INTERVAL=2;UNTIL=20250705T080000Z")},
+			expected: []string{
+				"2025-07-01T08:00:00Z", "2025-07-03T08:00:00Z", "2025-07-05T08:00:00Z",
+			},
+		},
+		{
+			name:   "Several Times A Day",
+			series: Series{Start: mustParseTime("2025-07-01T08:00:00Z"), Rule: mustParseRule("FREQ=DAILY;BYHOUR=8,14;BYMINUTE=0,30;COUNT=5")},
+			expected: []string{
+				"2025-07-01T08:00:00Z", "2025-07-01T08:30:00Z", "2025-07-01T14:00:00Z",
+				"2025-07-01T14:30:00Z", "2025-07-02T08:00:00Z",
+			},
+		},
+		{
+			name: "Exception And Extra Dates",
+			series: Series{
+				Start:   mustParseTime("2025-07-01T09:00:00Z"),
+				Rule:    mustParseRule("FREQ=DAILY;COUNT=3"),
+				ExDates: []time.Time{mustParseTime("2025-07-02T09:00:00Z")},
+				RDates:  []time.Time{mustParseTime("2025-07-10T09:00:00Z"), mustParseTime("2025-07-03T09:00:00Z")},
+			},
+			expected: []string{
+				"2025-07-01T09:00:00Z", "2025-07-03T09:00:00Z", "2025-07-10T09:00:00Z",
+			},
+		},
+		{
+			name:     "Window",
+			series:   Series{Start: mustParseTime("2025-01-06T09:00:00Z"), Duration: 2 * time.Hour, Rule: mustParseRule("FREQ=WEEKLY")},
+			from:     "2025-07-14T10:00:00Z",
+			to:       "2025-07-28T09:00:00Z",
+			expected: []string{"2025-07-14T09:00:00Z", "2025-07-21T09:00:00Z", "2025-07-28T09:00:00Z"},
+		},
+		{
+			name:     "Single Occurrence",
+			series:   Series{Start: mustParseTime("2025-07-01T09:00:00Z")},
+			expected: []string{"2025-07-01T09:00:00Z"},
+		},
+		{
+			name:     "Rule That Never Matches",
+			series:   Series{Start: mustParseTime("2025-01-30T00:00:00Z"), Rule: mustParseRule("FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30")},
+			expected: []string{"2025-01-30T00:00:00Z"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var from, to time.Time
+			if tc.from != "" {
+				from = mustParseTime(tc.from)
+			}
+			if tc.to != "" {
+				to = mustParseTime(tc.to)
+			}
+			occurrences, truncated := tc.series.Expand(from, to, 100)
+			assert.False(t, truncated)
+			assert.Equal(t, tc.expected, starts(occurrences))
+		})
+	}
+}
+
+func TestExpand_TruncatesUnboundedRules(t *testing.T) {
+	series := Series{
+		Start:  mustParseTime("2025-07-01T09:00:00Z"),
+		Rule:   mustParseRule("FREQ=DAILY"),
+		RDates: []time.Time{mustParseTime("2030-01-01T09:30:00Z")},
+	}
+
+	occurrences, truncated := series.Expand(time.Time{}, time.Time{}, 3)
+
+	assert.True(t, truncated)
+	assert.Equal(t, []string{"2025-07-01T09:00:00Z", "2025-07-02T09:00:00Z", "2025-07-03T09:00:00Z"}, starts(occurrences))
+}
+
+func TestExpand_FarFromStart(t *testing.T) {
+	everyMinute := "FREQ=DAILY;BYHOUR=0,1,2,3,4,5,6,7,8,9,10,11,12,13,14,15,16,17,18,19,20,21,22,23;" +
+		"BYMINUTE=0,1,2,3,4,5,6,7,8,9,10,11,12,13,14,15,16,17,18,19,20,21,22,23,24,25,26,27,28,29," +
+		"30,31,32,33,34,35,36,37,38,39,40,41,42,43,44,45,46,47,48,49,50,51,52,53,54,55,56,57,58,59"
+	from, to := mustParseTime("2199-06-01T00:00:00Z"), mustParseTime("2199-06-01T00:02:00Z")
+
+	t.Run("Periods Before The Range Are Skipped", func(t *testing.T) {
+		series := Series{Start: mustParseTime("1900-01-01T00:00:00Z"), Rule: mustParseRule(everyMinute)}
+
+		occurrences, truncated := series.Expand(from, to, 100)
+
+		assert.False(t, truncated)
+		assert.Equal(t, []string{"2199-06-01T00:00:00Z", "2199-06-01T00:01:00Z", "2199-06-01T00:02:00Z"}, starts(occurrences))
+	})
+
+	t.Run("Occurrences Reaching Into The Range Are Kept", func(t *testing.T) {
+		series := Series{Start: mustParseTime("1900-01-01T09:00:00Z"), Duration: 72 * time.Hour, Rule: mustParseRule("FREQ=WEEKLY")}
+
+		occurrences, truncated := series.Expand(mustParseTime("2199-05-29T00:00:00Z"), mustParseTime("2199-05-29T00:00:00Z"), 100)
+
+		assert.False(t, truncated)
+		assert.Equal(t, []string{"2199-05-27T09:00:00Z"}, starts(occurrences))
+	})
+
+	t.Run("Counted Rules Give Up", func(t *testing.T) {
+		series := Series{Start: mustParseTime("1900-01-01T00:00:00Z"), Rule: mustParseRule(everyMinute + ";COUNT=500000000")}
+
+		occurrences, truncated := series.Expand(from, to, 100)
+
+		assert.True(t, truncated)
+		assert.Empty(t, occurrences)
+	})
+}
+
+func TestExpand_OccurrenceEnds(t *testing.T) {
+	series := Series{Start: mustParseTime("2025-07-01T09:00:00Z"), Duration: 90 * time.Minute}
+
+	occurrences, _ := series.Expand(time.Time{}, time.Time{}, 10)
+
+	require.Len(t, occurrences, 1)
+	assert.Equal(t, mustParseTime("2025-07-01T10:30:00Z"), occurrences[0].End)
+}
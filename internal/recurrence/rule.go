@@ -0,0 +1,210 @@
+package recurrence
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Frequency string
+
+const (
+	Daily   Frequency = "DAILY"
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+	Yearly  Frequency = "YEARLY"
+)
+
+// WeekdayNum is a BYDAY entry such as "MO" or "-1FR". N is zero when the
+// entry has no ordinal and matches every such weekday of the period.
+type WeekdayNum struct {
+	Weekday time.Weekday
+	N       int
+}
+
+// Rule is a parsed RFC 5545 RRULE. Only the parts that describe day-level
+// schedules are supported: FREQ of DAILY, WEEKLY, MONTHLY or YEARLY together
+// with INTERVAL, COUNT, UNTIL, BYMONTH, BYMONTHDAY, BYDAY, BYSETPOS, BYHOUR,
+// BYMINUTE, BYSECOND and WKST.
+type Rule struct {
+	Freq       Frequency
+	Interval   int
+	Count      int
+	Until      time.Time
+	ByMonth    []int
+	ByMonthDay []int
+	ByDay      []WeekdayNum
+	BySetPos   []int
+	ByHour     []int
+	ByMinute   []int
+	BySecond   []int
+	WeekStart  time.Weekday
+}
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// Parse reads an RRULE value such as "FREQ=WEEKLY;BYDAY=MO,WE". A leading
+// "RRULE:" is accepted.
+func Parse(s string) (Rule, error) {
+	rule := Rule{Interval: 1, WeekStart: time.Monday}
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	if s == "" {
+		return rule, fmt.Errorf("rrule is empty")
+	}
+
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(s, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		name = strings.ToUpper(strings.TrimSpace(name))
+		if !ok || value == "" {
+			return rule, fmt.Errorf("rrule part %q must look like NAME=VALUE", part)
+		}
+		if seen[name] {
+			return rule, fmt.Errorf("rrule part %s is repeated", name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			rule.Freq, err = parseFrequency(value)
+		case "INTERVAL":
+			rule.Interval, err = parseNumber(name, value, 1, 0)
+		case "COUNT":
+			rule.Count, err = parseNumber(name, value, 1, 0)
+		case "UNTIL":
+			rule.Until, err = parseUntil(value)
+		case "BYMONTH":
+			rule.ByMonth, err = parseList(name, value, 1, 12, false)
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseList(name, value, 1, 31, true)
+		case "BYDAY":
+			rule.ByDay, err = parseByDay(value)
+		case "BYSETPOS":
+			rule.BySetPos, err = parseList(name, value, 1, 366, true)
+		case "BYHOUR":
+			rule.ByHour, err = parseList(name, value, 0, 23, false)
+		case "BYMINUTE":
+			rule.ByMinute, err = parseList(name, value, 0, 59, false)
+		case "BYSECOND":
+			rule.BySecond, err = parseList(name, value, 0, 59, false)
+		case "WKST":
+			wd, known := weekdays[strings.ToUpper(value)]
+			if !known {
+				err = fmt.Errorf("WKST %q is not a weekday", value)
+			}
+			rule.WeekStart = wd
+		default:
+			err = fmt.Errorf("rrule part %s is not supported", name)
+		}
+		if err != nil {
+			return rule, err
+		}
+	}
+
+	if rule.Freq == "" {
+		return rule, fmt.Errorf("rrule needs a FREQ")
+	}
+	if rule.Count > 0 && !rule.Until.IsZero() {
+		return rule, fmt.Errorf("rrule must not have both COUNT and UNTIL")
+	}
+	for _, d := range rule.ByDay {
+		if d.N != 0 && rule.Freq != Monthly && rule.Freq != Yearly {
+			return rule, fmt.Errorf("BYDAY ordinals need FREQ=MONTHLY or FREQ=YEARLY")
+		}
+	}
+	if rule.Freq == Weekly && len(rule.ByMonthDay) > 0 {
+		return rule, fmt.Errorf("BYMONTHDAY cannot be used with FREQ=WEEKLY")
+	}
+	return rule, nil
+}
+
+func parseFrequency(value string) (Frequency, error) {
+	freq := Frequency(strings.ToUpper(value))
+	switch freq {
+	case Daily, Weekly, Monthly, Yearly:
+		return freq, nil
+	}
+	return "", fmt.Errorf("FREQ %q is not supported, use DAILY, WEEKLY, MONTHLY or YEARLY", value)
+}
+
+func parseUntil(value string) (time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102T150405", "20060102"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			if layout == "20060102" {
+				// A date-only UNTIL includes the whole day.
+				t = t.Add(24*time.Hour - time.Second)
+			}
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("UNTIL %q must look like 20250131 or 20250131T235959Z", value)
+}
+
+// parseNumber parses a single integer within [min, max]; max 0 means no upper limit.
+func parseNumber(name, value string, min, max int) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < min || (max > 0 && n > max) {
+		if max > 0 {
+			return 0, fmt.Errorf("%s %q must be a number from %d to %d", name, value, min, max)
+		}
+		return 0, fmt.Errorf("%s %q must be a number of at least %d", name, value, min)
+	}
+	return n, nil
+}
+
+// parseList parses a comma separated list of integers within [min, max].
+// When negative is set the negated range is accepted too, counting from the end.
+func parseList(name, value string, min, max int, negative bool) ([]int, error) {
+	parts := strings.Split(value, ",")
+	list := make([]int, 0, len(parts))
+	for _, p := range parts {
+		n, err := strconv.Atoi(strings.TrimPrefix(p, "+"))
+		if err == nil && negative && n < 0 {
+			n = -n
+			if n >= min && n <= max {
+				list = append(list, -n)
+				continue
+			}
+		}
+		if err != nil || n < min || n > max {
+			return nil, fmt.Errorf("%s value %q is out of range", name, p)
+		}
+		list = append(list, n)
+	}
+	return list, nil
+}
+
+func parseByDay(value string) ([]WeekdayNum, error) {
+	parts := strings.Split(value, ",")
+	days := make([]WeekdayNum, 0, len(parts))
+	for _, p := range parts {
+		p = strings.ToUpper(p)
+		if len(p) < 2 {
+			return nil, fmt.Errorf("BYDAY value %q is not a weekday", p)
+		}
+		wd, ok := weekdays[p[len(p)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("BYDAY value %q is not a weekday", p)
+		}
+		day := WeekdayNum{Weekday: wd}
+		if ordinal := p[:len(p)-2]; ordinal != "" {
+			n, err := strconv.Atoi(strings.TrimPrefix(ordinal, "+"))
+			if err != nil || n == 0 || n < -53 || n > 53 {
+				return nil, fmt.Errorf("BYDAY value %q has an invalid ordinal", p)
+			}
+			day.N = n
+		}
+		days = append(days, day)
+	}
+	return days, nil
+}
@@ -0,0 +1,88 @@
+package recurrence
+
+import (
+	"sort"
+	"time"
+)
+
+// Series is a recurring range: an occurrence of Duration starting at Start,
+// repeated by an optional Rule, with RDates added and ExDates removed.
+type Series struct {
+	Start    time.Time
+	Duration time.Duration
+	Rule     *Rule
+	RDates   []time.Time
+	ExDates  []time.Time
+}
+
+type Occurrence struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Expand returns the occurrences that touch [from, to], in start order. A zero
+// from or to leaves that side open. Expansion stops after max occurrences, in
+// which case truncated is set and later occurrences are left out, so an
+// unbounded rule never grows past max. It also stops, setting truncated, when
+// the rule has gone through too many instances to get there.
+func (s Series) Expand(from, to time.Time, max int) (occurrences []Occurrence, truncated bool) {
+	excluded := make(map[int64]bool, len(s.ExDates))
+	for _, t := range s.ExDates {
+		excluded[t.UnixNano()] = true
+	}
+	keep := func(start time.Time) bool {
+		if excluded[start.UnixNano()] {
+			return false
+		}
+		return from.IsZero() || !start.Add(s.Duration).Before(from)
+	}
+	inRange := func(start time.Time) bool {
+		return to.IsZero() || !start.After(to)
+	}
+
+	starts := make([]time.Time, 0)
+	var cutoff time.Time
+	collect := func(start time.Time) bool {
+		if !inRange(start) {
+			return false
+		}
+		if !keep(start) {
+			return true
+		}
+		if len(starts) == max {
+			cutoff = start
+			return false
+		}
+		starts = append(starts, start)
+		return true
+	}
+	complete := true
+	if s.Rule != nil {
+		var skip time.Time
+		if !from.IsZero() {
+			skip = from.Add(-s.Duration)
+		}
+		complete = s.Rule.each(s.Start, skip, to, collect)
+	} else {
+		collect(s.Start)
+	}
+
+	for _, start := range s.RDates {
+		if inRange(start) && keep(start) && (cutoff.IsZero() || start.Before(cutoff)) {
+			starts = append(starts, start)
+		}
+	}
+	sort.Slice(starts, func(i, j int) bool { return starts[i].Before(starts[j]) })
+
+	occurrences = make([]Occurrence, 0, len(starts))
+	for i, start := range starts {
+		if i > 0 && start.Equal(starts[i-1]) {
+			continue
+		}
+		if len(occurrences) == max {
+			return occurrences, true
+		}
+		occurrences = append(occurrences, Occurrence{Start: start, End: start.Add(s.Duration)})
+	}
+	return occurrences, !cutoff.IsZero() || !complete
+}
@@ -0,0 +1,74 @@
+// Package zone turns wall-clock times in named IANA zones into instants. Zones
+// come from the host's zoneinfo when it has one; the embedded copy of the
+// database is only a fallback for hosts without it, so a host with an outdated
+// zoneinfo can give different answers for recently changed zones.
+package zone
+
+import (
+	"fmt"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/keshu12345/overlap-avalara/data"
+)
+
+// Load returns the named IANA zone. "UTC" is accepted; the empty name and
+// "Local" are not, since they would make answers depend on the host.
+func Load(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return nil, fmt.Errorf("time zone %q is not an IANA zone name", name)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown time zone %q", name)
+	}
+	return loc, nil
+}
+
+// Resolve returns the instant at which clocks in loc show wall, whose fields
+// are read in UTC. A wall time skipped by a DST change is handled by gap and a
+// repeated one by fold; empty policies mean shift_forward and earlier.
+func Resolve(wall time.Time, loc *time.Location, gap data.GapPolicy, fold data.FoldPolicy) (time.Time, error) {
+	candidates := candidates(wall, loc)
+	switch len(candidates) {
+	case 1:
+		return candidates[0], nil
+	case 0:
+		if gap == data.GapReject {
+			return time.Time{}, fmt.Errorf("%s does not exist in %s, clocks skip it", wall.Format("2006-01-02T15:04:05"), loc)
+		}
+		// Read the wall time with the offset in force before the change; the
+		// instant then lands after the change, shifted by the gap length.
+		_, before := wall.Add(-24 * time.Hour).In(loc).Zone()
+		return wall.Add(-time.Duration(before) * time.Second).In(loc), nil
+	default:
+		switch fold {
+		case data.FoldReject:
+			return time.Time{}, fmt.Errorf("%s occurs twice in %s, clocks repeat it", wall.Format("2006-01-02T15:04:05"), loc)
+		case data.FoldLater:
+			return candidates[len(candidates)-1], nil
+		}
+		return candidates[0], nil
+	}
+}
+
+// candidates returns, in order, every instant at which clocks in loc show
+// wall. The offsets in force a day either side are the only ones that can
+// apply, as zones do not change offset twice within a day.
+func candidates(wall time.Time, loc *time.Location) []time.Time {
+	found := make([]time.Time, 0, 2)
+	for _, probe := range []time.Time{wall.Add(-24 * time.Hour), wall.Add(24 * time.Hour)} {
+		_, offset := probe.In(loc).Zone()
+		t := wall.Add(-time.Duration(offset) * time.Second)
+		if _, actual := t.In(loc).Zone(); actual != offset {
+			continue
+		}
+		if len(found) == 0 || !found[0].Equal(t) {
+			found = append(found, t.In(loc))
+		}
+	}
+	if len(found) == 2 && found[1].Before(found[0]) {
+		found[0], found[1] = found[1], found[0]
+	}
+	return found
+}
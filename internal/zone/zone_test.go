@@ -0,0 +1,74 @@
+package zone
+
+import (
+	"testing"
+	"time"
+
+	"github.com/keshu12345/overlap-avalara/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func wall(s string) time.Time {
+	t, err := time.Parse("2006-01-02T15:04:05", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestLoad(t *testing.T) {
+	loc, err := Load("America/New_York")
+	require.NoError(t, err)
+	assert.Equal(t, "America/New_York", loc.String())
+
+	_, err = Load("UTC")
+	assert.NoError(t, err)
+
+	for _, name := range []string{"", "Local", "Mars/Olympus_Mons"} {
+		_, err := Load(name)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestResolve(t *testing.T) {
+	newYork, err := Load("America/New_York")
+	require.NoError(t, err)
+	kolkata, err := Load("Asia/Kolkata")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		wall     string
+		loc      *time.Location
+		gap      data.GapPolicy
+		fold     data.FoldPolicy
+		expected string
+		wantErr  bool
+	}{
+		{name: "Standard Time", wall: "2025-01-15T09:00:00", loc: newYork, expected: "2025-01-15T14:00:00Z"},
+		{name: "Daylight Time", wall: "2025-07-15T09:00:00", loc: newYork, expected: "2025-07-15T13:00:00Z"},
+		{name: "Half Hour Offset", wall: "2025-07-15T09:00:00", loc: kolkata, expected: "2025-07-15T03:30:00Z"},
+		{name: "Skipped Time Shifts Forward", wall: "2025-03-09T02:30:00", loc: newYork, expected: "2025-03-09T07:30:00Z"},
+		{name: "Skipped Time Rejected", wall: "2025-03-09T02:30:00", loc: newYork, gap: data.GapReject, wantErr: true},
+		{name: "Repeated Time Earlier", wall: "2025-11-02T01:30:00", loc: newYork, expected: "2025-11-02T05:30:00Z"},
+		{name: "Repeated Time Later", wall: "2025-11-02T01:30:00", loc: newYork, fold: data.FoldLater, expected: "2025-11-02T06:30:00Z"},
+		{name: "Repeated Time Rejected", wall: "2025-11-02T01:30:00", loc: newYork, fold: data.FoldReject, wantErr: true},
+		{name: "Just After The Gap", wall: "2025-03-09T03:00:00", loc: newYork, gap: data.GapReject, expected: "2025-03-09T07:00:00Z"},
+		{name: "Just After The Fold", wall: "2025-11-02T02:00:00", loc: newYork, fold: data.FoldReject, expected: "2025-11-02T07:00:00Z"},
+		{name: "UTC", wall: "2025-03-09T02:30:00", loc: time.UTC, gap: data.GapReject, expected: "2025-03-09T02:30:00Z"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Resolve(wall(tc.wall), tc.loc, tc.gap, tc.fold)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got.UTC().Format(time.RFC3339))
+			assert.Equal(t, tc.loc, got.Location())
+		})
+	}
+}